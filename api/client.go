@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -74,6 +75,21 @@ func (tr funcTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 // Client facilitates making HTTP requests to the GitHub API
 type Client struct {
 	http *http.Client
+	ctx  context.Context
+}
+
+// WithContext returns a shallow copy of the client whose requests are bound
+// to ctx, so that canceling ctx aborts any in-flight request
+func (c Client) WithContext(ctx context.Context) *Client {
+	c.ctx = ctx
+	return &c
+}
+
+func (c Client) context() context.Context {
+	if c.ctx != nil {
+		return c.ctx
+	}
+	return context.Background()
 }
 
 type graphQLResponse struct {
@@ -109,7 +125,7 @@ func (c Client) GraphQL(query string, variables map[string]interface{}, data int
 		return err
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(reqBody))
+	req, err := http.NewRequestWithContext(c.context(), "POST", url, bytes.NewBuffer(reqBody))
 	if err != nil {
 		return err
 	}
@@ -128,7 +144,7 @@ func (c Client) GraphQL(query string, variables map[string]interface{}, data int
 // REST performs a REST request and parses the response.
 func (c Client) REST(method string, p string, body io.Reader, data interface{}) error {
 	url := "https://api.github.com/" + p
-	req, err := http.NewRequest(method, url, body)
+	req, err := http.NewRequestWithContext(c.context(), method, url, body)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path"
 	"strings"
+	"sync"
 )
 
 // FakeHTTP provides a mechanism by which to stub HTTP responses through
@@ -17,6 +18,7 @@ type FakeHTTP struct {
 	Requests      []*http.Request
 	count         int
 	responseStubs []*http.Response
+	mu            sync.Mutex
 }
 
 // StubResponse pre-records an HTTP response
@@ -30,6 +32,9 @@ func (f *FakeHTTP) StubResponse(status int, body io.Reader) {
 
 // RoundTrip satisfies http.RoundTripper
 func (f *FakeHTTP) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
 	if len(f.responseStubs) <= f.count {
 		return nil, fmt.Errorf("FakeHTTP: missing response stub for request %d", f.count)
 	}
@@ -39,3 +39,28 @@ func TestPullRequest_ChecksStatus(t *testing.T) {
 	eq(t, checks.Failing, 3)
 	eq(t, checks.Passing, 2)
 }
+
+func TestPullRequest_ChecksList(t *testing.T) {
+	pr := PullRequest{}
+	payload := `
+	{ "commits": { "nodes": [{ "commit": {
+		"statusCheckRollup": {
+			"contexts": {
+				"nodes": [
+					{ "context": "build", "state": "SUCCESS" },
+					{ "name": "lint", "status": "COMPLETED", "conclusion": "FAILURE" }
+				]
+			}
+		}
+	} }] } }
+	`
+	err := json.Unmarshal([]byte(payload), &pr)
+	eq(t, err, nil)
+
+	checks := pr.ChecksList()
+	eq(t, len(checks), 2)
+	eq(t, checks[0].Name, "build")
+	eq(t, checks[0].State, "SUCCESS")
+	eq(t, checks[1].Name, "lint")
+	eq(t, checks[1].State, "FAILURE")
+}
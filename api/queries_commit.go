@@ -0,0 +1,121 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// CommitStatusCheck is a single status check or check run reported against a commit
+type CommitStatusCheck struct {
+	State      string
+	Status     string
+	Conclusion string
+}
+
+// RepoCommit is a commit looked up by its SHA, along with status checks and
+// any pull requests it is associated with
+type RepoCommit struct {
+	Oid               string
+	MessageHeadline   string
+	Message           string
+	StatusCheckRollup struct {
+		Contexts struct {
+			Nodes []CommitStatusCheck
+		}
+	}
+	AssociatedPullRequests struct {
+		Nodes []PullRequest
+	}
+}
+
+// ChecksStatus summarizes the status checks reported against a commit
+func (c *RepoCommit) ChecksStatus() (summary PullRequestChecksStatus) {
+	for _, check := range c.StatusCheckRollup.Contexts.Nodes {
+		state := check.State
+		if state == "" {
+			if check.Status == "COMPLETED" {
+				state = check.Conclusion
+			} else {
+				state = check.Status
+			}
+		}
+		switch state {
+		case "SUCCESS", "NEUTRAL", "SKIPPED":
+			summary.Passing++
+		case "ERROR", "FAILURE", "CANCELLED", "TIMED_OUT", "ACTION_REQUIRED":
+			summary.Failing++
+		case "EXPECTED", "REQUESTED", "QUEUED", "PENDING", "IN_PROGRESS", "STALE":
+			summary.Pending++
+		default:
+			// an unrecognized state (e.g. WAITING, STARTUP_FAILURE) is bucketed
+			// as pending rather than failing the whole summary
+			summary.Pending++
+		}
+		summary.Total++
+	}
+	return
+}
+
+// CommitBySHA looks up a single commit by its SHA or other git revision expression
+func CommitBySHA(client *Client, repo ghrepo.Interface, sha string) (*RepoCommit, error) {
+	type response struct {
+		Repository struct {
+			Object *RepoCommit
+		}
+	}
+
+	query := `
+	query($owner: String!, $repo: String!, $sha: String!) {
+		repository(owner: $owner, name: $repo) {
+			object(expression: $sha) {
+				... on Commit {
+					oid
+					messageHeadline
+					message
+					statusCheckRollup {
+						contexts(first: 100) {
+							nodes {
+								... on StatusContext {
+									state
+								}
+								... on CheckRun {
+									status
+									conclusion
+								}
+							}
+						}
+					}
+					associatedPullRequests(first: 10) {
+						nodes {
+							number
+							title
+							state
+							url
+							baseRefName
+							headRefName
+						}
+					}
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+		"sha":   sha,
+	}
+
+	var resp response
+	err := client.GraphQL(query, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Repository.Object == nil {
+		return nil, &NotFoundError{fmt.Errorf("no commit found for %q", sha)}
+	}
+
+	return resp.Repository.Object, nil
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+func TestCommitBySHA(t *testing.T) {
+	http := &FakeHTTP{}
+	client := NewClient(ReplaceTripper(http))
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "object": {
+		"oid": "deadbeef",
+		"messageHeadline": "Fix the thing",
+		"message": "Fix the thing\n\nLonger description.",
+		"statusCheckRollup": {
+			"contexts": { "nodes": [
+				{ "state": "SUCCESS" },
+				{ "status": "COMPLETED", "conclusion": "FAILURE" }
+			] }
+		},
+		"associatedPullRequests": { "nodes": [
+			{ "number": 1, "title": "Fix the thing", "state": "OPEN" }
+		] }
+	} } } }
+	`))
+
+	commit, err := CommitBySHA(client, ghrepo.FromFullName("OWNER/REPO"), "deadbeef")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	checks := commit.ChecksStatus()
+	if checks.Passing != 1 || checks.Failing != 1 || checks.Total != 2 {
+		t.Errorf("unexpected checks summary: %+v", checks)
+	}
+	if len(commit.AssociatedPullRequests.Nodes) != 1 {
+		t.Errorf("expected 1 associated pull request, got %d", len(commit.AssociatedPullRequests.Nodes))
+	}
+}
+
+func TestCommitBySHA_notFound(t *testing.T) {
+	http := &FakeHTTP{}
+	client := NewClient(ReplaceTripper(http))
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "object": null } } }
+	`))
+
+	_, err := CommitBySHA(client, ghrepo.FromFullName("OWNER/REPO"), "deadbeef")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cli/cli/internal/ghrepo"
@@ -19,6 +20,7 @@ type IssuesAndTotalCount struct {
 }
 
 type Issue struct {
+	ID        string
 	Number    int
 	Title     string
 	URL       string
@@ -44,6 +46,7 @@ type IssueLabel struct {
 
 const fragments = `
 	fragment issue on Issue {
+		id
 		number
 		title
 		url
@@ -277,6 +280,7 @@ func IssueByNumber(client *Client, repo ghrepo.Interface, number int) (*Issue, e
 		repository(owner: $owner, name: $repo) {
 			hasIssuesEnabled
 			issue(number: $issue_number) {
+				id
 				title
 				body
 				author {
@@ -314,3 +318,120 @@ func IssueByNumber(client *Client, repo ghrepo.Interface, number int) (*Issue, e
 
 	return &resp.Repository.Issue, nil
 }
+
+// IssueTransfer moves an issue to a different repository
+func IssueTransfer(client *Client, issue *Issue, targetRepo *Repository) error {
+	query := `
+	mutation TransferIssue($issueId: ID!, $repositoryId: ID!) {
+		transferIssue(input: {issueId: $issueId, repositoryId: $repositoryId}) {
+			issue {
+				url
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"issueId":      issue.ID,
+		"repositoryId": targetRepo.ID,
+	}
+
+	result := struct {
+		TransferIssue struct {
+			Issue Issue
+		}
+	}{}
+
+	err := client.GraphQL(query, variables, &result)
+	if err != nil {
+		return err
+	}
+
+	issue.URL = result.TransferIssue.Issue.URL
+	return nil
+}
+
+// IssuePin pins an issue to the top of the issues list for its repository
+func IssuePin(client *Client, issue *Issue) error {
+	query := `
+	mutation PinIssue($issueId: ID!) {
+		pinIssue(input: {issueId: $issueId}) {
+			issue {
+				id
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{"issueId": issue.ID}
+
+	var result struct{}
+	return client.GraphQL(query, variables, &result)
+}
+
+// IssueUnpin unpins an issue
+func IssueUnpin(client *Client, issue *Issue) error {
+	query := `
+	mutation UnpinIssue($issueId: ID!) {
+		unpinIssue(input: {issueId: $issueId}) {
+			issue {
+				id
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{"issueId": issue.ID}
+
+	var result struct{}
+	return client.GraphQL(query, variables, &result)
+}
+
+// validLockReasons are the lock reasons accepted by the lockLockable mutation
+var validLockReasons = map[string]bool{
+	"OFF_TOPIC":  true,
+	"TOO_HEATED": true,
+	"RESOLVED":   true,
+	"SPAM":       true,
+}
+
+// IssueLock locks conversation on an issue, optionally recording a reason.
+// reason is matched case-insensitively and may use spaces or hyphens in
+// place of underscores, e.g. "too heated" or "off-topic".
+func IssueLock(client *Client, issue *Issue, reason string) error {
+	lockReason := strings.ToUpper(strings.NewReplacer(" ", "_", "-", "_").Replace(reason))
+	if reason != "" && !validLockReasons[lockReason] {
+		return fmt.Errorf("invalid lock reason: %s", reason)
+	}
+
+	query := `
+	mutation LockLockable($lockableId: ID!, $lockReason: LockReason) {
+		lockLockable(input: {lockableId: $lockableId, lockReason: $lockReason}) {
+			lockedRecord {
+				locked
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{"lockableId": issue.ID}
+	if reason != "" {
+		variables["lockReason"] = lockReason
+	}
+
+	var result struct{}
+	return client.GraphQL(query, variables, &result)
+}
+
+// IssueUnlock unlocks conversation on an issue
+func IssueUnlock(client *Client, issue *Issue) error {
+	query := `
+	mutation UnlockLockable($lockableId: ID!) {
+		unlockLockable(input: {lockableId: $lockableId}) {
+			unlockedRecord {
+				locked
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{"lockableId": issue.ID}
+
+	var result struct{}
+	return client.GraphQL(query, variables, &result)
+}
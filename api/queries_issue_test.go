@@ -66,3 +66,136 @@ func TestIssueList(t *testing.T) {
 		t.Errorf("expected %q, got %q", "ENDCURSOR", endCursor)
 	}
 }
+
+func TestIssueLock(t *testing.T) {
+	http := &FakeHTTP{}
+	client := NewClient(ReplaceTripper(http))
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "lockLockable": { "lockedRecord": { "locked": true } } } }
+	`))
+
+	issue := &Issue{ID: "THE-ID"}
+	err := IssueLock(client, issue, "too heated")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var reqBody struct {
+		Variables map[string]interface{}
+	}
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[0].Body)
+	json.Unmarshal(bodyBytes, &reqBody)
+	if reqBody.Variables["lockReason"] != "TOO_HEATED" {
+		t.Errorf("expected %q, got %q", "TOO_HEATED", reqBody.Variables["lockReason"])
+	}
+}
+
+func TestIssueLock_invalidReason(t *testing.T) {
+	client := NewClient()
+	err := IssueLock(client, &Issue{ID: "THE-ID"}, "because I said so")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestIssueTransfer(t *testing.T) {
+	http := &FakeHTTP{}
+	client := NewClient(ReplaceTripper(http))
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "transferIssue": { "issue": {
+		"url": "https://github.com/OWNER/NEWREPO/issues/123"
+	} } } }
+	`))
+
+	issue := &Issue{ID: "THE-ID", URL: "https://github.com/OWNER/REPO/issues/123"}
+	err := IssueTransfer(client, issue, &Repository{ID: "TARGET-ID"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var reqBody struct {
+		Variables map[string]interface{}
+	}
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[0].Body)
+	json.Unmarshal(bodyBytes, &reqBody)
+	if reqBody.Variables["issueId"] != "THE-ID" {
+		t.Errorf("expected %q, got %q", "THE-ID", reqBody.Variables["issueId"])
+	}
+	if reqBody.Variables["repositoryId"] != "TARGET-ID" {
+		t.Errorf("expected %q, got %q", "TARGET-ID", reqBody.Variables["repositoryId"])
+	}
+	if issue.URL != "https://github.com/OWNER/NEWREPO/issues/123" {
+		t.Errorf("expected issue URL to be updated, got %q", issue.URL)
+	}
+}
+
+func TestIssuePin(t *testing.T) {
+	http := &FakeHTTP{}
+	client := NewClient(ReplaceTripper(http))
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "pinIssue": { "issue": { "id": "THE-ID" } } } }
+	`))
+
+	err := IssuePin(client, &Issue{ID: "THE-ID"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var reqBody struct {
+		Variables map[string]interface{}
+	}
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[0].Body)
+	json.Unmarshal(bodyBytes, &reqBody)
+	if reqBody.Variables["issueId"] != "THE-ID" {
+		t.Errorf("expected %q, got %q", "THE-ID", reqBody.Variables["issueId"])
+	}
+}
+
+func TestIssueUnpin(t *testing.T) {
+	http := &FakeHTTP{}
+	client := NewClient(ReplaceTripper(http))
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "unpinIssue": { "issue": { "id": "THE-ID" } } } }
+	`))
+
+	err := IssueUnpin(client, &Issue{ID: "THE-ID"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var reqBody struct {
+		Variables map[string]interface{}
+	}
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[0].Body)
+	json.Unmarshal(bodyBytes, &reqBody)
+	if reqBody.Variables["issueId"] != "THE-ID" {
+		t.Errorf("expected %q, got %q", "THE-ID", reqBody.Variables["issueId"])
+	}
+}
+
+func TestIssueUnlock(t *testing.T) {
+	http := &FakeHTTP{}
+	client := NewClient(ReplaceTripper(http))
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "unlockLockable": { "unlockedRecord": { "locked": false } } } }
+	`))
+
+	err := IssueUnlock(client, &Issue{ID: "THE-ID"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var reqBody struct {
+		Variables map[string]interface{}
+	}
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[0].Body)
+	json.Unmarshal(bodyBytes, &reqBody)
+	if reqBody.Variables["lockableId"] != "THE-ID" {
+		t.Errorf("expected %q, got %q", "THE-ID", reqBody.Variables["lockableId"])
+	}
+}
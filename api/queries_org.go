@@ -22,3 +22,9 @@ func resolveOrganizationTeam(client *Client, orgName, teamSlug string) (string,
 	err := client.REST("GET", fmt.Sprintf("orgs/%s/teams/%s", orgName, teamSlug), nil, &response)
 	return response.Organization.NodeID, response.NodeID, err
 }
+
+// TeamID resolves a team's slug within an organization to its node ID
+func TeamID(client *Client, orgName, teamSlug string) (string, error) {
+	_, teamID, err := resolveOrganizationTeam(client, orgName, teamSlug)
+	return teamID, err
+}
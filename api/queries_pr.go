@@ -19,6 +19,7 @@ type PullRequestAndTotalCount struct {
 }
 
 type PullRequest struct {
+	ID          string
 	Number      int
 	Title       string
 	State       string
@@ -52,6 +53,8 @@ type PullRequest struct {
 				StatusCheckRollup struct {
 					Contexts struct {
 						Nodes []struct {
+							Name       string
+							Context    string
 							State      string
 							Status     string
 							Conclusion string
@@ -63,6 +66,13 @@ type PullRequest struct {
 	}
 }
 
+// PullRequestCheck is a single status check or check run reported against a
+// pull request's head commit
+type PullRequestCheck struct {
+	Name  string
+	State string
+}
+
 type NotFoundError struct {
 	error
 }
@@ -101,33 +111,48 @@ type PullRequestChecksStatus struct {
 }
 
 func (pr *PullRequest) ChecksStatus() (summary PullRequestChecksStatus) {
+	for _, c := range pr.ChecksList() {
+		switch c.State {
+		case "SUCCESS", "NEUTRAL", "SKIPPED":
+			summary.Passing++
+		case "ERROR", "FAILURE", "CANCELLED", "TIMED_OUT", "ACTION_REQUIRED":
+			summary.Failing++
+		case "EXPECTED", "REQUESTED", "QUEUED", "PENDING", "IN_PROGRESS", "STALE":
+			summary.Pending++
+		default:
+			// an unrecognized state (e.g. WAITING, STARTUP_FAILURE) is bucketed
+			// as pending rather than failing the whole summary
+			summary.Pending++
+		}
+		summary.Total++
+	}
+	return
+}
+
+// ChecksList returns the individual status checks and check runs reported
+// against the pull request's head commit
+func (pr *PullRequest) ChecksList() []PullRequestCheck {
 	if len(pr.Commits.Nodes) == 0 {
-		return
+		return nil
 	}
+
 	commit := pr.Commits.Nodes[0].Commit
+	checks := make([]PullRequestCheck, 0, len(commit.StatusCheckRollup.Contexts.Nodes))
 	for _, c := range commit.StatusCheckRollup.Contexts.Nodes {
-		state := c.State // StatusContext
+		name := c.Context // StatusContext
+		state := c.State
 		if state == "" {
 			// CheckRun
+			name = c.Name
 			if c.Status == "COMPLETED" {
 				state = c.Conclusion
 			} else {
 				state = c.Status
 			}
 		}
-		switch state {
-		case "SUCCESS", "NEUTRAL", "SKIPPED":
-			summary.Passing++
-		case "ERROR", "FAILURE", "CANCELLED", "TIMED_OUT", "ACTION_REQUIRED":
-			summary.Failing++
-		case "EXPECTED", "REQUESTED", "QUEUED", "PENDING", "IN_PROGRESS", "STALE":
-			summary.Pending++
-		default:
-			panic(fmt.Errorf("unsupported status: %q", state))
-		}
-		summary.Total++
+		checks = append(checks, PullRequestCheck{Name: name, State: state})
 	}
-	return
+	return checks
 }
 
 func PullRequests(client *Client, repo ghrepo.Interface, currentPRNumber int, currentPRHeadRef, currentUsername string) (*PullRequestsPayload, error) {
@@ -166,9 +191,11 @@ func PullRequests(client *Client, repo ghrepo.Interface, currentPRNumber int, cu
 						contexts(last: 100) {
 							nodes {
 								...on StatusContext {
+									context
 									state
 								}
 								...on CheckRun {
+									name
 									status
 									conclusion
 								}
@@ -298,6 +325,7 @@ func PullRequestByNumber(client *Client, repo ghrepo.Interface, number int) (*Pu
 	query($owner: String!, $repo: String!, $pr_number: Int!) {
 		repository(owner: $owner, name: $repo) {
 			pullRequest(number: $pr_number) {
+				id
 				url
 				number
 				title
@@ -305,8 +333,27 @@ func PullRequestByNumber(client *Client, repo ghrepo.Interface, number int) (*Pu
 				author {
 				  login
 				}
-				commits {
+				commits(last: 1) {
 				  totalCount
+				  nodes {
+					commit {
+						statusCheckRollup {
+							contexts(last: 100) {
+								nodes {
+									...on StatusContext {
+										context
+										state
+									}
+									...on CheckRun {
+										name
+										status
+										conclusion
+									}
+								}
+							}
+						}
+					}
+				  }
 				}
 				baseRefName
 				headRefName
@@ -354,14 +401,34 @@ func PullRequestForBranch(client *Client, repo ghrepo.Interface, baseBranch, hea
 		repository(owner: $owner, name: $repo) {
 			pullRequests(headRefName: $headRefName, states: OPEN, first: 30) {
 				nodes {
+					id
 					number
 					title
 					body
 					author {
 						login
 					}
-					commits {
+					commits(last: 1) {
 						totalCount
+						nodes {
+							commit {
+								statusCheckRollup {
+									contexts(last: 100) {
+										nodes {
+											...on StatusContext {
+												context
+												state
+											}
+											...on CheckRun {
+												name
+												status
+												conclusion
+											}
+										}
+									}
+								}
+							}
+						}
 					}
 					url
 					baseRefName
@@ -413,6 +480,7 @@ func CreatePullRequest(client *Client, repo *Repository, params map[string]inter
 		mutation CreatePullRequest($input: CreatePullRequestInput!) {
 			createPullRequest(input: $input) {
 				pullRequest {
+					id
 					url
 				}
 			}
@@ -442,6 +510,78 @@ func CreatePullRequest(client *Client, repo *Repository, params map[string]inter
 	return &result.CreatePullRequest.PullRequest, nil
 }
 
+// RequestReviews requests reviews on pr from the given users and teams
+func RequestReviews(client *Client, pr *PullRequest, userIDs, teamIDs []string) error {
+	query := `
+	mutation RequestReviews($pullRequestId: ID!, $userIds: [ID!], $teamIds: [ID!]) {
+		requestReviews(input: {pullRequestId: $pullRequestId, userIds: $userIds, teamIds: $teamIds, union: true}) {
+			pullRequest {
+				id
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"pullRequestId": pr.ID,
+		"userIds":       userIDs,
+		"teamIds":       teamIDs,
+	}
+
+	var result struct{}
+	return client.GraphQL(query, variables, &result)
+}
+
+// SuggestedReviewer is a user GitHub recommends for reviewing a pull request,
+// based on the files changed and who has reviewed or authored them before
+type SuggestedReviewer struct {
+	Login string
+}
+
+// PullRequestSuggestedReviewers fetches GitHub's suggested reviewers for an
+// existing pull request
+func PullRequestSuggestedReviewers(client *Client, repo ghrepo.Interface, number int) ([]SuggestedReviewer, error) {
+	type response struct {
+		Repository struct {
+			PullRequest struct {
+				SuggestedReviewers []struct {
+					Reviewer SuggestedReviewer
+				}
+			}
+		}
+	}
+
+	query := `
+	query($owner: String!, $repo: String!, $number: Int!) {
+		repository(owner: $owner, name: $repo) {
+			pullRequest(number: $number) {
+				suggestedReviewers {
+					reviewer {
+						login
+					}
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner":  repo.RepoOwner(),
+		"repo":   repo.RepoName(),
+		"number": number,
+	}
+
+	var resp response
+	err := client.GraphQL(query, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewers := make([]SuggestedReviewer, len(resp.Repository.PullRequest.SuggestedReviewers))
+	for i, sr := range resp.Repository.PullRequest.SuggestedReviewers {
+		reviewers[i] = sr.Reviewer
+	}
+	return reviewers, nil
+}
+
 func PullRequestList(client *Client, vars map[string]interface{}, limit int) (*PullRequestAndTotalCount, error) {
 	type prBlock struct {
 		Edges []struct {
@@ -0,0 +1,173 @@
+package api
+
+import (
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// RepoLabel is a label that can be applied to issues and pull requests
+type RepoLabel struct {
+	ID   string
+	Name string
+}
+
+// RepoMilestone is a milestone that issues and pull requests can be assigned to
+type RepoMilestone struct {
+	ID    string
+	Title string
+}
+
+// RepoAssignableUser is a user who can be assigned to issues and pull requests
+type RepoAssignableUser struct {
+	ID    string
+	Login string
+}
+
+// RepositoryLabels fetches the labels defined on a repository
+func RepositoryLabels(client *Client, repo ghrepo.Interface) ([]RepoLabel, error) {
+	type response struct {
+		Repository struct {
+			Labels struct {
+				Nodes []RepoLabel
+			}
+		}
+	}
+
+	query := `
+	query($owner: String!, $repo: String!) {
+		repository(owner: $owner, name: $repo) {
+			labels(first: 100) {
+				nodes {
+					id
+					name
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+	}
+
+	var resp response
+	err := client.GraphQL(query, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Repository.Labels.Nodes, nil
+}
+
+// RepositoryMilestones fetches the open milestones defined on a repository
+func RepositoryMilestones(client *Client, repo ghrepo.Interface) ([]RepoMilestone, error) {
+	type response struct {
+		Repository struct {
+			Milestones struct {
+				Nodes []RepoMilestone
+			}
+		}
+	}
+
+	query := `
+	query($owner: String!, $repo: String!) {
+		repository(owner: $owner, name: $repo) {
+			milestones(first: 100, states: OPEN) {
+				nodes {
+					id
+					title
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+	}
+
+	var resp response
+	err := client.GraphQL(query, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Repository.Milestones.Nodes, nil
+}
+
+// RepositoryAssignableUsers fetches the users that can be assigned to issues
+// and pull requests on a repository
+func RepositoryAssignableUsers(client *Client, repo ghrepo.Interface) ([]RepoAssignableUser, error) {
+	type response struct {
+		Repository struct {
+			AssignableUsers struct {
+				Nodes []RepoAssignableUser
+			}
+		}
+	}
+
+	query := `
+	query($owner: String!, $repo: String!) {
+		repository(owner: $owner, name: $repo) {
+			assignableUsers(first: 100) {
+				nodes {
+					id
+					login
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+	}
+
+	var resp response
+	err := client.GraphQL(query, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Repository.AssignableUsers.Nodes, nil
+}
+
+// RepositoryBranchNames fetches the names of branches that exist on a repository
+func RepositoryBranchNames(client *Client, repo ghrepo.Interface) ([]string, error) {
+	type response struct {
+		Repository struct {
+			Refs struct {
+				Nodes []struct {
+					Name string
+				}
+			}
+		}
+	}
+
+	query := `
+	query($owner: String!, $repo: String!) {
+		repository(owner: $owner, name: $repo) {
+			refs(refPrefix: "refs/heads/", first: 100) {
+				nodes {
+					name
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+	}
+
+	var resp response
+	err := client.GraphQL(query, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(resp.Repository.Refs.Nodes))
+	for _, n := range resp.Repository.Refs.Nodes {
+		names = append(names, n.Name)
+	}
+	return names, nil
+}
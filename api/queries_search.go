@@ -0,0 +1,68 @@
+package api
+
+import "net/url"
+
+// SearchResult is an issue or pull request matched by a search query
+type SearchResult struct {
+	Number int
+	Title  string
+	URL    string
+}
+
+// SearchIssues runs a search across all of GitHub for issues and pull
+// requests matching query, returning up to 100 results
+func SearchIssues(client *Client, query string) (results []SearchResult, totalCount int, err error) {
+	type response struct {
+		Search struct {
+			IssueCount int
+			Nodes      []SearchResult
+		}
+	}
+
+	graphqlQuery := `
+	query($q: String!) {
+		search(query: $q, type: ISSUE, first: 100) {
+			issueCount
+			nodes {
+				...on Issue {
+					number
+					title
+					url
+				}
+				...on PullRequest {
+					number
+					title
+					url
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{"q": query}
+
+	var resp response
+	err = client.GraphQL(graphqlQuery, variables, &resp)
+	if err != nil {
+		return
+	}
+
+	results = resp.Search.Nodes
+	totalCount = resp.Search.IssueCount
+	return
+}
+
+// SearchCommitCount returns the number of commits across all of GitHub that
+// match query, using the REST commit search endpoint since GraphQL has no
+// commit search
+func SearchCommitCount(client *Client, query string) (int, error) {
+	var response struct {
+		TotalCount int `json:"total_count"`
+	}
+
+	err := client.REST("GET", "search/commits?q="+url.QueryEscape(query), nil, &response)
+	if err != nil {
+		return 0, err
+	}
+
+	return response.TotalCount, nil
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSearchIssues(t *testing.T) {
+	http := &FakeHTTP{}
+	client := NewClient(ReplaceTripper(http))
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "search": {
+		"issueCount": 2,
+		"nodes": [
+			{ "number": 1, "title": "Fix the thing", "url": "https://github.com/OWNER/REPO/pull/1" },
+			{ "number": 2, "title": "Fix the other thing", "url": "https://github.com/OWNER/REPO/issues/2" }
+		]
+	} } }
+	`))
+
+	results, totalCount, err := SearchIssues(client, "is:merged author:monalisa")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if totalCount != 2 || len(results) != 2 {
+		t.Errorf("expected 2 results, got %d (total %d)", len(results), totalCount)
+	}
+	if results[0].Number != 1 {
+		t.Errorf("unexpected first result: %+v", results[0])
+	}
+}
+
+func TestSearchCommitCount(t *testing.T) {
+	http := &FakeHTTP{}
+	client := NewClient(ReplaceTripper(http))
+
+	http.StubResponse(200, bytes.NewBufferString(`{ "total_count": 42 }`))
+
+	count, err := SearchCommitCount(client, "author:monalisa")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 42 {
+		t.Errorf("expected 42, got %d", count)
+	}
+}
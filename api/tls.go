@@ -0,0 +1,151 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+
+	"golang.org/x/net/proxy"
+)
+
+// TLSConfig customizes how the API client connects to GitHub: a custom CA
+// bundle and client certificate for GitHub Enterprise instances sitting
+// behind a MITM proxy, and an optional SOCKS5 proxy for corporate networks.
+type TLSConfig struct {
+	CABundleFile       string
+	ClientCertFile     string
+	ClientKeyFile      string
+	ProxyURL           string
+	InsecureSkipVerify bool
+}
+
+// AddTLSConfig wires a TLSConfig into the transport. It is a no-op, safe
+// default when cfg is the zero value, in which case http.DefaultTransport's
+// ordinary HTTPS_PROXY/NO_PROXY handling is left untouched.
+func AddTLSConfig(cfg TLSConfig) (ClientOption, error) {
+	if cfg == (TLSConfig{}) {
+		return func(tr http.RoundTripper) http.RoundTripper { return tr }, nil
+	}
+
+	base, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("unexpected default transport type %T", http.DefaultTransport)
+	}
+	transport := base.Clone()
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify}
+	if cfg.CABundleFile != "" {
+		pool, err := certPoolFromFile(cfg.CABundleFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cfg.ClientCertFile != "" || cfg.ClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	dialer, err := socks5Dialer(cfg.ProxyURL)
+	if err != nil {
+		return nil, err
+	}
+	if dialer != nil {
+		// a SOCKS5 proxy is a plain dialer, not something `Transport.Proxy`
+		// (which only understands CONNECT-based HTTP(S) proxies) can drive
+		transport.Proxy = nil
+		transport.DialContext = nil
+		transport.Dial = dialer.Dial
+	}
+
+	return func(http.RoundTripper) http.RoundTripper {
+		return transport
+	}, nil
+}
+
+func certPoolFromFile(fn string) (*x509.CertPool, error) {
+	data, err := ioutil.ReadFile(fn)
+	if err != nil {
+		return nil, fmt.Errorf("could not read CA bundle %s: %w", fn, err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in %s", fn)
+	}
+	return pool, nil
+}
+
+// socks5Dialer resolves a SOCKS5 dialer from an explicit proxy URL or, if
+// none was given, from the standard ALL_PROXY/HTTPS_PROXY environment
+// variables. It returns a nil dialer when no SOCKS5 proxy is configured.
+func socks5Dialer(proxyURL string) (proxy.Dialer, error) {
+	raw := proxyURL
+	if raw == "" {
+		for _, key := range []string{"ALL_PROXY", "all_proxy", "HTTPS_PROXY", "https_proxy"} {
+			if v := os.Getenv(key); v != "" {
+				raw = v
+				break
+			}
+		}
+	}
+	if raw == "" {
+		return nil, nil
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL %q: %w", raw, err)
+	}
+	if u.Scheme != "socks5" && u.Scheme != "socks5h" {
+		return nil, nil
+	}
+
+	return proxy.FromURL(u, proxy.Direct)
+}
+
+// ProbeTLS performs a lightweight request against the GitHub API and reports
+// the negotiated TLS version, to help diagnose corporate proxy/CA issues.
+func (c Client) ProbeTLS() (string, error) {
+	req, err := http.NewRequestWithContext(c.context(), "HEAD", "https://api.github.com/", nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.TLS == nil {
+		return "", fmt.Errorf("connection to %s was not encrypted", req.URL.Hostname())
+	}
+	return tlsVersionName(resp.TLS.Version), nil
+}
+
+func tlsVersionName(v uint16) string {
+	switch v {
+	case tls.VersionTLS10:
+		return "TLS 1.0"
+	case tls.VersionTLS11:
+		return "TLS 1.1"
+	case tls.VersionTLS12:
+		return "TLS 1.2"
+	case tls.VersionTLS13:
+		return "TLS 1.3"
+	default:
+		return "unknown TLS version"
+	}
+}
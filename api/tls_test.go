@@ -0,0 +1,39 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestAddTLSConfig_zeroValueIsNoop(t *testing.T) {
+	opt, err := AddTLSConfig(TLSConfig{})
+	eq(t, err, nil)
+
+	tr := opt(http.DefaultTransport)
+	if tr != http.DefaultTransport {
+		t.Errorf("expected the default transport to be returned unmodified")
+	}
+}
+
+func TestAddTLSConfig_missingCABundle(t *testing.T) {
+	_, err := AddTLSConfig(TLSConfig{CABundleFile: "/does/not/exist"})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestSocks5Dialer_ignoresHTTPProxy(t *testing.T) {
+	dialer, err := socks5Dialer("https://proxy.example.com:8080")
+	eq(t, err, nil)
+	if dialer != nil {
+		t.Errorf("expected no SOCKS5 dialer for an https:// proxy URL")
+	}
+}
+
+func TestSocks5Dialer_socks5Scheme(t *testing.T) {
+	dialer, err := socks5Dialer("socks5://proxy.example.com:1080")
+	eq(t, err, nil)
+	if dialer == nil {
+		t.Errorf("expected a SOCKS5 dialer for a socks5:// proxy URL")
+	}
+}
@@ -1,16 +1,18 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
+	"os/signal"
 	"path"
 	"strings"
 
 	"github.com/cli/cli/command"
-	"github.com/cli/cli/context"
+	ghcontext "github.com/cli/cli/context"
 	"github.com/cli/cli/update"
 	"github.com/cli/cli/utils"
 	"github.com/mgutz/ansi"
@@ -20,6 +22,15 @@ import (
 var updaterEnabled = ""
 
 func main() {
+	ctx, stop := context.WithCancel(context.Background())
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt)
+	go func() {
+		<-sigCh
+		stop()
+	}()
+	command.SetContext(ctx)
+
 	currentVersion := command.Version
 	updateMessageChan := make(chan *update.ReleaseInfo)
 	go func() {
@@ -30,7 +41,9 @@ func main() {
 	hasDebug := os.Getenv("DEBUG") != ""
 
 	if cmd, err := command.RootCmd.ExecuteC(); err != nil {
-		printError(os.Stderr, err, cmd, hasDebug)
+		if !errors.Is(err, command.SilentError) {
+			printError(os.Stderr, err, cmd, hasDebug)
+		}
 		os.Exit(1)
 	}
 
@@ -84,6 +97,6 @@ func checkForUpdate(currentVersion string) (*update.ReleaseInfo, error) {
 	}
 
 	repo := updaterEnabled
-	stateFilePath := path.Join(context.ConfigDir(), "state.yml")
+	stateFilePath := path.Join(ghcontext.ConfigDir(), "state.yml")
 	return update.CheckForUpdate(client, stateFilePath, repo, currentVersion)
 }
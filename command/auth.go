@@ -0,0 +1,102 @@
+package command
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	RootCmd.AddCommand(authCmd)
+	authCmd.AddCommand(authStatusCmd)
+	authStatusCmd.Flags().Bool("show-tls", false, "Include proxy and TLS connection diagnostics")
+}
+
+var authCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "Check authentication status and connectivity to GitHub",
+}
+
+var authStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "View authentication status",
+	Long: `View authentication status.
+
+Pass "--show-tls" to additionally report on the proxy and TLS settings gh
+will use when talking to GitHub, which is useful when diagnosing connectivity
+through a corporate proxy or to a GitHub Enterprise instance.`,
+	RunE: authStatus,
+}
+
+func authStatus(cmd *cobra.Command, args []string) error {
+	ctx := contextForCommand(cmd)
+	out := colorableOut(cmd)
+
+	username, err := ctx.AuthLogin()
+	if err != nil || username == "" {
+		fmt.Fprintf(out, "%s Not logged in to github.com\n", utils.Red("x"))
+		return nil
+	}
+	fmt.Fprintf(out, "%s Logged in to github.com as %s\n", utils.Green("✓"), utils.Bold(username))
+
+	showTLS, err := cmd.Flags().GetBool("show-tls")
+	if err != nil {
+		return err
+	}
+	if !showTLS {
+		return nil
+	}
+
+	tlsConfig, err := ctx.TLSConfig()
+	if err != nil {
+		return err
+	}
+
+	apiClient, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
+
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, utils.Bold("TLS diagnostics"))
+	if proxyURL := effectiveProxyURL(tlsConfig); proxyURL != "" {
+		fmt.Fprintf(out, "  proxy:       %s\n", proxyURL)
+	} else {
+		fmt.Fprintln(out, "  proxy:       none")
+	}
+	if tlsConfig.CABundleFile != "" {
+		fmt.Fprintf(out, "  CA bundle:   %s\n", tlsConfig.CABundleFile)
+	}
+	if tlsConfig.ClientCertFile != "" {
+		fmt.Fprintf(out, "  client cert: %s\n", tlsConfig.ClientCertFile)
+	}
+	if tlsConfig.InsecureSkipVerify {
+		fmt.Fprintf(out, "  %s certificate verification is disabled\n", utils.Yellow("!"))
+	}
+
+	version, err := apiClient.ProbeTLS()
+	if err != nil {
+		fmt.Fprintf(out, "  %s could not establish a TLS connection to api.github.com: %s\n", utils.Red("x"), err)
+		return nil
+	}
+	fmt.Fprintf(out, "  %s TLS handshake succeeded (%s)\n", utils.Green("✓"), version)
+
+	return nil
+}
+
+func effectiveProxyURL(cfg api.TLSConfig) string {
+	if cfg.ProxyURL != "" {
+		return cfg.ProxyURL
+	}
+	for _, key := range []string{"ALL_PROXY", "all_proxy", "HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy"} {
+		if v := os.Getenv(key); v != "" {
+			return v
+		}
+	}
+	return ""
+}
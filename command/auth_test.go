@@ -0,0 +1,40 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/context"
+)
+
+func TestAuthStatus_loggedOut(t *testing.T) {
+	initContext = func() context.Context {
+		return context.NewBlank()
+	}
+
+	output, err := RunCommand(authStatusCmd, "auth status")
+	if err != nil {
+		t.Fatalf("error running command `auth status`: %v", err)
+	}
+
+	if !strings.Contains(output.String(), "Not logged in to github.com") {
+		t.Errorf("expected output to report not logged in, got: %q", output.String())
+	}
+}
+
+func TestAuthStatus_loggedIn(t *testing.T) {
+	initContext = func() context.Context {
+		ctx := context.NewBlank()
+		ctx.SetAuthLogin("monalisa")
+		return ctx
+	}
+
+	output, err := RunCommand(authStatusCmd, "auth status")
+	if err != nil {
+		t.Fatalf("error running command `auth status`: %v", err)
+	}
+
+	if !strings.Contains(output.String(), "Logged in to github.com as monalisa") {
+		t.Errorf("expected output to report the logged-in user, got: %q", output.String())
+	}
+}
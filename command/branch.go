@@ -0,0 +1,138 @@
+package command
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/git"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	RootCmd.AddCommand(branchCmd)
+	branchCmd.AddCommand(branchListCmd)
+	branchListCmd.Flags().BoolP("local", "l", false, "List local branches only, without querying the remote")
+}
+
+// branchListConcurrency bounds how many PullRequestForBranch lookups run at
+// once, since each branch needs its own GraphQL round trip
+const branchListConcurrency = 4
+
+var branchCmd = &cobra.Command{
+	Use:   "branch",
+	Short: "List and inspect branches",
+}
+var branchListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List remote branches and their associated pull requests",
+	RunE:  branchList,
+}
+
+func branchList(cmd *cobra.Command, args []string) error {
+	ctx := contextForCommand(cmd)
+
+	apiClient, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
+
+	baseRepo, err := determineBaseRepo(cmd, ctx)
+	if err != nil {
+		return err
+	}
+
+	localOnly, err := cmd.Flags().GetBool("local")
+	if err != nil {
+		return err
+	}
+
+	localBranches, err := git.Branches()
+	if err != nil {
+		return err
+	}
+	local := make(map[string]git.Branch, len(localBranches))
+	for _, b := range localBranches {
+		local[b.Name] = b
+	}
+
+	type branchRow struct {
+		name string
+		b    *git.Branch
+	}
+
+	var rows []branchRow
+	if localOnly {
+		for _, b := range localBranches {
+			b := b
+			rows = append(rows, branchRow{b.Name, &b})
+		}
+	} else {
+		remoteBranches, err := cachedRepoBranches(apiClient, baseRepo)
+		if err != nil {
+			return err
+		}
+		for _, name := range remoteBranches {
+			if b, ok := local[name]; ok {
+				b := b
+				rows = append(rows, branchRow{name, &b})
+			} else {
+				rows = append(rows, branchRow{name, nil})
+			}
+		}
+	}
+
+	// look up each branch's pull request concurrently, bounded so a repo
+	// with many branches doesn't open unlimited simultaneous requests
+	prStatuses := make([]string, len(rows))
+	sem := make(chan struct{}, branchListConcurrency)
+	var wg sync.WaitGroup
+	wg.Add(len(rows))
+	for i, row := range rows {
+		i, row := i, row
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if pr, err := api.PullRequestForBranch(apiClient, baseRepo, "", row.name); err == nil {
+				prStatuses[i] = fmt.Sprintf("#%d %s", pr.Number, pr.State)
+			}
+		}()
+	}
+	wg.Wait()
+
+	out := cmd.OutOrStdout()
+	table := utils.NewTablePrinter(out)
+
+	for i, row := range rows {
+		aheadBehind := "not checked out"
+		upstream := ""
+		nameColor := utils.Gray
+		if row.b != nil {
+			nameColor = utils.Bold
+			upstream = row.b.Upstream
+			aheadBehind = ""
+			switch {
+			case row.b.Gone:
+				aheadBehind = "gone"
+			case row.b.AheadCount > 0 && row.b.BehindCount > 0:
+				aheadBehind = fmt.Sprintf("ahead %d, behind %d", row.b.AheadCount, row.b.BehindCount)
+			case row.b.AheadCount > 0:
+				aheadBehind = fmt.Sprintf("ahead %d", row.b.AheadCount)
+			case row.b.BehindCount > 0:
+				aheadBehind = fmt.Sprintf("behind %d", row.b.BehindCount)
+			}
+		}
+
+		table.AddField(row.name, nil, nameColor)
+		table.AddField(upstream, nil, utils.Cyan)
+		table.AddField(aheadBehind, nil, utils.Gray)
+		table.AddField(prStatuses[i], nil, utils.Gray)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/internal/run"
+	"github.com/cli/cli/test"
+)
+
+func TestBranchList_remote(t *testing.T) {
+	defer withTempCacheDir(t)()
+
+	initBlankContext("OWNER/REPO", "master")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "refs": { "nodes": [
+		{ "name": "master" },
+		{ "name": "feature" }
+	] } } } }
+	`))
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "pullRequests": { "nodes": [
+		{ "number": 1, "state": "OPEN", "headRefName": "master", "isCrossRepository": false }
+	] } } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "pullRequests": { "nodes": [] } } } }
+	`))
+
+	restoreCmd := run.SetPrepareCmd(func(cmd *exec.Cmd) run.Runnable {
+		return &test.OutputStub{}
+	})
+	defer restoreCmd()
+
+	output, err := RunCommand(branchListCmd, "branch list")
+	if err != nil {
+		t.Fatalf("error running command `branch list`: %v", err)
+	}
+
+	out := output.String()
+	if !strings.Contains(out, "master") || !strings.Contains(out, "feature") {
+		t.Errorf("expected output to list both remote branches, got: %q", out)
+	}
+	if !strings.Contains(out, "not checked out") {
+		t.Errorf("expected output to mark the branch with no local copy, got: %q", out)
+	}
+}
+
+func TestBranchList_local(t *testing.T) {
+	initBlankContext("OWNER/REPO", "master")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "pullRequests": { "nodes": [] } } } }
+	`))
+
+	cs, teardown := test.InitCmdStubber()
+	defer teardown()
+	cs.Stub("master\torigin/master\t\n")
+
+	output, err := RunCommand(branchListCmd, "branch list --local")
+	if err != nil {
+		t.Fatalf("error running command `branch list --local`: %v", err)
+	}
+
+	if !strings.Contains(output.String(), "master") {
+		t.Errorf("expected output to list the local branch, got: %q", output.String())
+	}
+}
@@ -0,0 +1,29 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/internal/cache"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	RootCmd.AddCommand(cacheCmd)
+	cacheCmd.AddCommand(cacheClearCmd)
+}
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the local cache of repository metadata",
+}
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Remove cached repository metadata (labels, milestones, assignable users, branches)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := cache.Clear(); err != nil {
+			return fmt.Errorf("could not clear cache: %w", err)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), "Cache cleared")
+		return nil
+	},
+}
@@ -0,0 +1,86 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	RootCmd.AddCommand(commitCmd)
+	commitCmd.AddCommand(commitViewCmd)
+}
+
+var commitCmd = &cobra.Command{
+	Use:   "commit",
+	Short: "View commits",
+}
+var commitViewCmd = &cobra.Command{
+	Use: "view <sha>",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return FlagError{errors.New("commit SHA required as argument")}
+		}
+		return nil
+	},
+	Short: "View a commit's status checks and associated pull requests",
+	RunE:  commitView,
+}
+
+func commitView(cmd *cobra.Command, args []string) error {
+	ctx := contextForCommand(cmd)
+
+	apiClient, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
+
+	baseRepo, err := determineBaseRepo(cmd, ctx)
+	if err != nil {
+		return err
+	}
+
+	commit, err := api.CommitBySHA(apiClient, baseRepo, args[0])
+	if err != nil {
+		return err
+	}
+
+	out := colorableOut(cmd)
+
+	fmt.Fprintln(out, utils.Bold(commit.MessageHeadline))
+	if commit.Message != commit.MessageHeadline {
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, commit.Message)
+	}
+	fmt.Fprintln(out)
+
+	checks := commit.ChecksStatus()
+	if checks.Total > 0 {
+		fmt.Fprintln(out, utils.Bold("Status checks"))
+		switch {
+		case checks.Failing > 0:
+			fmt.Fprintln(out, utils.Red(fmt.Sprintf("× %d/%d checks failing", checks.Failing, checks.Total)))
+		case checks.Pending > 0:
+			fmt.Fprintln(out, utils.Yellow("- Checks pending"))
+		default:
+			fmt.Fprintln(out, utils.Green("✓ All checks passing"))
+		}
+		fmt.Fprintln(out)
+	}
+
+	prs := commit.AssociatedPullRequests.Nodes
+	if len(prs) == 0 {
+		fmt.Fprintln(out, "This commit is not associated with any pull requests")
+		return nil
+	}
+
+	printHeader(out, "Associated pull requests")
+	printPrs(out, len(prs), prs...)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package command
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCommitView(t *testing.T) {
+	initBlankContext("OWNER/REPO", "blueberries")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "object": {
+		"oid": "deadbeef",
+		"messageHeadline": "Fix the thing",
+		"message": "Fix the thing",
+		"statusCheckRollup": { "contexts": { "nodes": [
+			{ "state": "SUCCESS" }
+		] } },
+		"associatedPullRequests": { "nodes": [
+			{ "number": 1, "title": "Fix the thing", "state": "OPEN" }
+		] }
+	} } } }
+	`))
+
+	output, err := RunCommand(commitViewCmd, "commit view deadbeef")
+	if err != nil {
+		t.Fatalf("error running command `commit view`: %v", err)
+	}
+
+	test := output.String()
+	if !bytes.Contains([]byte(test), []byte("Fix the thing")) {
+		t.Errorf("expected output to contain commit headline, got: %q", test)
+	}
+	if !bytes.Contains([]byte(test), []byte("All checks passing")) {
+		t.Errorf("expected output to report passing checks, got: %q", test)
+	}
+	if !bytes.Contains([]byte(test), []byte("#1")) {
+		t.Errorf("expected output to list associated pull request, got: %q", test)
+	}
+}
@@ -10,6 +10,13 @@ import (
 func init() {
 	RootCmd.AddCommand(completionCmd)
 	completionCmd.Flags().StringP("shell", "s", "bash", "Shell type: {bash|zsh|fish|powershell}")
+
+	RootCmd.AddCommand(completeRepoMetadataCmd)
+	RootCmd.BashCompletionFunction = bashCustomCompletionFuncs
+
+	_ = issueCreateCmd.MarkFlagCustom("label", "__gh_repo_labels")
+	_ = issueCreateCmd.MarkFlagCustom("milestone", "__gh_repo_milestones")
+	_ = issueCreateCmd.MarkFlagCustom("assignee", "__gh_repo_assignable_users")
 }
 
 var completionCmd = &cobra.Command{
@@ -45,3 +52,88 @@ Homebrew, see <https://docs.brew.sh/Shell-Completion>
 		}
 	},
 }
+
+// completeRepoMetadataCmd prints one cached repo label/milestone/assignee
+// name per line for the current directory's base repo, so the bash
+// functions named in bashCustomCompletionFuncs can shell out to it for
+// dynamic completion instead of only completing static flag values
+var completeRepoMetadataCmd = &cobra.Command{
+	Use:    "__complete-repo-metadata {labels|milestones|assignees}",
+	Args:   cobra.ExactArgs(1),
+	Hidden: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := contextForCommand(cmd)
+		apiClient, err := apiClientForContext(ctx)
+		if err != nil {
+			return nil
+		}
+
+		baseRepo, err := determineBaseRepo(cmd, ctx)
+		if err != nil {
+			return nil
+		}
+
+		out := cmd.OutOrStdout()
+		switch args[0] {
+		case "labels":
+			labels, err := cachedRepoLabels(apiClient, baseRepo)
+			if err != nil {
+				return nil
+			}
+			for _, l := range labels {
+				fmt.Fprintln(out, l.Name)
+			}
+		case "milestones":
+			milestones, err := cachedRepoMilestones(apiClient, baseRepo)
+			if err != nil {
+				return nil
+			}
+			for _, m := range milestones {
+				fmt.Fprintln(out, m.Title)
+			}
+		case "assignees":
+			users, err := cachedRepoAssignableUsers(apiClient, baseRepo)
+			if err != nil {
+				return nil
+			}
+			for _, u := range users {
+				fmt.Fprintln(out, u.Login)
+			}
+		default:
+			return fmt.Errorf("unsupported metadata kind %q", args[0])
+		}
+
+		return nil
+	},
+}
+
+// bashCustomCompletionFuncs is injected into the generated bash completion
+// script to complete --label/--milestone/--assignee by shelling back out to
+// the cache-backed completeRepoMetadataCmd. Errors (e.g. no net access, not
+// in a repo) are swallowed there so a slow or failing lookup just falls back
+// to no suggestions rather than breaking completion.
+const bashCustomCompletionFuncs = `
+__gh_repo_labels()
+{
+	local ghcmd_out
+	if ghcmd_out=$(gh __complete-repo-metadata labels 2>/dev/null); then
+		COMPREPLY=( $(compgen -W "${ghcmd_out}" -- "$cur") )
+	fi
+}
+
+__gh_repo_milestones()
+{
+	local ghcmd_out
+	if ghcmd_out=$(gh __complete-repo-metadata milestones 2>/dev/null); then
+		COMPREPLY=( $(compgen -W "${ghcmd_out}" -- "$cur") )
+	fi
+}
+
+__gh_repo_assignable_users()
+{
+	local ghcmd_out
+	if ghcmd_out=$(gh __complete-repo-metadata assignees 2>/dev/null); then
+		COMPREPLY=( $(compgen -W "${ghcmd_out}" -- "$cur") )
+	fi
+}
+`
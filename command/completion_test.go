@@ -14,6 +14,9 @@ func TestCompletion_bash(t *testing.T) {
 	if !strings.Contains(output.String(), "complete -o default -F __start_gh gh") {
 		t.Errorf("problem in bash completion:\n%s", output)
 	}
+	if !strings.Contains(output.String(), "__gh_repo_labels()") {
+		t.Errorf("expected bash completion to define the repo metadata helper functions:\n%s", output)
+	}
 }
 
 func TestCompletion_zsh(t *testing.T) {
@@ -29,6 +29,9 @@ func init() {
 	issueCreateCmd.Flags().StringP("body", "b", "",
 		"Supply a body. Will prompt for one otherwise.")
 	issueCreateCmd.Flags().BoolP("web", "w", false, "Open the browser to create an issue")
+	issueCreateCmd.Flags().StringSliceP("label", "l", nil, "Add labels by name")
+	issueCreateCmd.Flags().StringP("milestone", "m", "", "Add the issue to a milestone by name")
+	issueCreateCmd.Flags().StringSliceP("assignee", "a", nil, "Assign people by their login")
 
 	issueCmd.AddCommand(issueListCmd)
 	issueListCmd.Flags().StringP("assignee", "a", "", "Filter by assignee")
@@ -39,6 +42,15 @@ func init() {
 
 	issueCmd.AddCommand(issueViewCmd)
 	issueViewCmd.Flags().BoolP("web", "w", false, "Open issue in browser")
+
+	issueCmd.AddCommand(issueTransferCmd)
+
+	issueCmd.AddCommand(issuePinCmd)
+	issueCmd.AddCommand(issueUnpinCmd)
+
+	issueCmd.AddCommand(issueLockCmd)
+	issueLockCmd.Flags().StringP("reason", "r", "", "Optional reason: {off-topic|too heated|resolved|spam}")
+	issueCmd.AddCommand(issueUnlockCmd)
 }
 
 var issueCmd = &cobra.Command{
@@ -76,6 +88,61 @@ var issueViewCmd = &cobra.Command{
 	Short: "View an issue",
 	RunE:  issueView,
 }
+var issueTransferCmd = &cobra.Command{
+	Use: "transfer {<number> | <url>} <destination-repo>",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 2 {
+			return FlagError{errors.New("issue number or URL and destination repository required as arguments")}
+		}
+		return nil
+	},
+	Short: "Transfer an issue to another repository",
+	RunE:  issueTransfer,
+}
+var issuePinCmd = &cobra.Command{
+	Use: "pin {<number> | <url>}",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return FlagError{errors.New("issue number or URL required as argument")}
+		}
+		return nil
+	},
+	Short: "Pin an issue to the top of the issues list",
+	RunE:  issuePin,
+}
+var issueUnpinCmd = &cobra.Command{
+	Use: "unpin {<number> | <url>}",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return FlagError{errors.New("issue number or URL required as argument")}
+		}
+		return nil
+	},
+	Short: "Unpin an issue",
+	RunE:  issueUnpin,
+}
+var issueLockCmd = &cobra.Command{
+	Use: "lock {<number> | <url>}",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return FlagError{errors.New("issue number or URL required as argument")}
+		}
+		return nil
+	},
+	Short: "Lock conversation on an issue",
+	RunE:  issueLock,
+}
+var issueUnlockCmd = &cobra.Command{
+	Use: "unlock {<number> | <url>}",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return FlagError{errors.New("issue number or URL required as argument")}
+		}
+		return nil
+	},
+	Short: "Unlock conversation on an issue",
+	RunE:  issueUnlock,
+}
 
 func issueList(cmd *cobra.Command, args []string) error {
 	ctx := contextForCommand(cmd)
@@ -83,6 +150,8 @@ func issueList(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
 
 	baseRepo, err := determineBaseRepo(cmd, ctx)
 	if err != nil {
@@ -144,6 +213,8 @@ func issueStatus(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
 
 	baseRepo, err := determineBaseRepo(cmd, ctx)
 	if err != nil {
@@ -201,6 +272,8 @@ func issueView(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
 
 	baseRepo, err := determineBaseRepo(cmd, ctx)
 	if err != nil {
@@ -228,6 +301,112 @@ func issueView(cmd *cobra.Command, args []string) error {
 
 }
 
+func issueTransfer(cmd *cobra.Command, args []string) error {
+	ctx := contextForCommand(cmd)
+
+	apiClient, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
+
+	baseRepo, err := determineBaseRepo(cmd, ctx)
+	if err != nil {
+		return err
+	}
+
+	issue, err := issueFromArg(apiClient, baseRepo, args[0])
+	if err != nil {
+		return err
+	}
+
+	targetRepo, err := api.GitHubRepo(apiClient, ghrepo.FromFullName(args[1]))
+	if err != nil {
+		return err
+	}
+
+	if err := api.IssueTransfer(apiClient, issue, targetRepo); err != nil {
+		return fmt.Errorf("failed to transfer issue: %w", err)
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), issue.URL)
+	return nil
+}
+
+func issuePin(cmd *cobra.Command, args []string) error {
+	return issueLockableAction(cmd, args, api.IssuePin, "Pinned issue #%d\n")
+}
+
+func issueUnpin(cmd *cobra.Command, args []string) error {
+	return issueLockableAction(cmd, args, api.IssueUnpin, "Unpinned issue #%d\n")
+}
+
+func issueUnlock(cmd *cobra.Command, args []string) error {
+	return issueLockableAction(cmd, args, api.IssueUnlock, "Unlocked conversation on issue #%d\n")
+}
+
+func issueLockableAction(cmd *cobra.Command, args []string, action func(*api.Client, *api.Issue) error, successMessage string) error {
+	ctx := contextForCommand(cmd)
+
+	apiClient, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
+
+	baseRepo, err := determineBaseRepo(cmd, ctx)
+	if err != nil {
+		return err
+	}
+
+	issue, err := issueFromArg(apiClient, baseRepo, args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := action(apiClient, issue); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), successMessage, issue.Number)
+	return nil
+}
+
+func issueLock(cmd *cobra.Command, args []string) error {
+	ctx := contextForCommand(cmd)
+
+	apiClient, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
+
+	baseRepo, err := determineBaseRepo(cmd, ctx)
+	if err != nil {
+		return err
+	}
+
+	issue, err := issueFromArg(apiClient, baseRepo, args[0])
+	if err != nil {
+		return err
+	}
+
+	reason, err := cmd.Flags().GetString("reason")
+	if err != nil {
+		return err
+	}
+
+	if err := api.IssueLock(apiClient, issue, reason); err != nil {
+		return fmt.Errorf("failed to lock issue: %w", err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Locked conversation on issue #%d\n", issue.Number)
+	return nil
+}
+
 func listHeader(repoName string, itemName string, matchCount int, totalMatchCount int, hasFilters bool) string {
 	if totalMatchCount == 0 {
 		if hasFilters {
@@ -343,6 +522,8 @@ func issueCreate(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
 
 	repo, err := api.GitHubRepo(apiClient, baseRepo)
 	if err != nil {
@@ -352,6 +533,21 @@ func issueCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("the '%s' repository has disabled issues", ghrepo.FullName(baseRepo))
 	}
 
+	labelNames, err := cmd.Flags().GetStringSlice("label")
+	if err != nil {
+		return err
+	}
+
+	milestoneTitle, err := cmd.Flags().GetString("milestone")
+	if err != nil {
+		return err
+	}
+
+	assigneeLogins, err := cmd.Flags().GetStringSlice("assignee")
+	if err != nil {
+		return err
+	}
+
 	action := SubmitAction
 
 	interactive := title == "" || body == ""
@@ -376,6 +572,13 @@ func issueCreate(cmd *cobra.Command, args []string) error {
 		if body == "" {
 			body = tb.Body
 		}
+
+		if action == SubmitAction && len(labelNames) == 0 && milestoneTitle == "" && len(assigneeLogins) == 0 {
+			labelNames, milestoneTitle, assigneeLogins, err = issueMetadataSurvey(apiClient, baseRepo)
+			if err != nil {
+				return fmt.Errorf("could not collect metadata: %w", err)
+			}
+		}
 	}
 
 	if action == PreviewAction {
@@ -394,6 +597,30 @@ func issueCreate(cmd *cobra.Command, args []string) error {
 			"body":  body,
 		}
 
+		if len(labelNames) > 0 {
+			labelIDs, err := labelNamesToIDs(apiClient, baseRepo, labelNames)
+			if err != nil {
+				return err
+			}
+			params["labelIds"] = labelIDs
+		}
+
+		if milestoneTitle != "" {
+			milestoneID, err := milestoneTitleToID(apiClient, baseRepo, milestoneTitle)
+			if err != nil {
+				return err
+			}
+			params["milestoneId"] = milestoneID
+		}
+
+		if len(assigneeLogins) > 0 {
+			assigneeIDs, err := assigneeLoginsToIDs(apiClient, baseRepo, assigneeLogins)
+			if err != nil {
+				return err
+			}
+			params["assigneeIds"] = assigneeIDs
+		}
+
 		newIssue, err := api.IssueCreate(apiClient, repo, params)
 		if err != nil {
 			return err
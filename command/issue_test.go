@@ -549,6 +549,170 @@ func TestIssueCreate_webTitleBody(t *testing.T) {
 	eq(t, output.String(), "Opening github.com/OWNER/REPO/issues/new in your browser.\n")
 }
 
+func TestIssueTransfer(t *testing.T) {
+	initBlankContext("OWNER/REPO", "master")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "ISSUE-ID",
+			"number": 123,
+			"url": "https://github.com/OWNER/REPO/issues/123"
+		} } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": {
+			"id": "TARGETREPO-ID",
+			"hasIssuesEnabled": true
+		} } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "transferIssue": { "issue": {
+			"url": "https://github.com/OWNER/NEWREPO/issues/123"
+		} } } }
+	`))
+
+	output, err := RunCommand(issueTransferCmd, "issue transfer 123 OWNER/NEWREPO")
+	if err != nil {
+		t.Errorf("error running command `issue transfer`: %v", err)
+	}
+
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[3].Body)
+	reqBody := struct {
+		Variables struct {
+			IssueID      string
+			RepositoryID string
+		}
+	}{}
+	json.Unmarshal(bodyBytes, &reqBody)
+
+	eq(t, reqBody.Variables.IssueID, "ISSUE-ID")
+	eq(t, reqBody.Variables.RepositoryID, "TARGETREPO-ID")
+	eq(t, output.String(), "https://github.com/OWNER/NEWREPO/issues/123\n")
+}
+
+func TestIssuePin(t *testing.T) {
+	initBlankContext("OWNER/REPO", "master")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "ISSUE-ID",
+			"number": 123
+		} } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "pinIssue": { "issue": { "id": "ISSUE-ID" } } } }
+	`))
+
+	output, err := RunCommand(issuePinCmd, "issue pin 123")
+	if err != nil {
+		t.Errorf("error running command `issue pin`: %v", err)
+	}
+
+	eq(t, output.String(), "Pinned issue #123\n")
+}
+
+func TestIssueUnpin(t *testing.T) {
+	initBlankContext("OWNER/REPO", "master")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "ISSUE-ID",
+			"number": 123
+		} } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "unpinIssue": { "issue": { "id": "ISSUE-ID" } } } }
+	`))
+
+	output, err := RunCommand(issueUnpinCmd, "issue unpin 123")
+	if err != nil {
+		t.Errorf("error running command `issue unpin`: %v", err)
+	}
+
+	eq(t, output.String(), "Unpinned issue #123\n")
+}
+
+func TestIssueLock(t *testing.T) {
+	initBlankContext("OWNER/REPO", "master")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "ISSUE-ID",
+			"number": 123
+		} } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "lockLockable": { "lockedRecord": { "locked": true } } } }
+	`))
+
+	output, err := RunCommand(issueLockCmd, "issue lock 123 --reason spam")
+	if err != nil {
+		t.Errorf("error running command `issue lock`: %v", err)
+	}
+
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[2].Body)
+	reqBody := struct {
+		Variables struct {
+			LockableID string
+			LockReason string
+		}
+	}{}
+	json.Unmarshal(bodyBytes, &reqBody)
+
+	eq(t, reqBody.Variables.LockableID, "ISSUE-ID")
+	eq(t, reqBody.Variables.LockReason, "SPAM")
+	eq(t, output.String(), "Locked conversation on issue #123\n")
+}
+
+func TestIssueLock_invalidReason(t *testing.T) {
+	initBlankContext("OWNER/REPO", "master")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "ISSUE-ID",
+			"number": 123
+		} } } }
+	`))
+
+	_, err := RunCommand(issueLockCmd, "issue lock 123 --reason boredom")
+	if err == nil || err.Error() != "failed to lock issue: invalid lock reason: boredom" {
+		t.Errorf("error running command `issue lock`: %v", err)
+	}
+}
+
+func TestIssueUnlock(t *testing.T) {
+	initBlankContext("OWNER/REPO", "master")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+			"id": "ISSUE-ID",
+			"number": 123
+		} } } }
+	`))
+	http.StubResponse(200, bytes.NewBufferString(`
+		{ "data": { "unlockLockable": { "unlockedRecord": { "locked": false } } } }
+	`))
+
+	output, err := RunCommand(issueUnlockCmd, "issue unlock 123")
+	if err != nil {
+		t.Errorf("error running command `issue unlock`: %v", err)
+	}
+
+	eq(t, output.String(), "Unlocked conversation on issue #123\n")
+}
+
 func Test_listHeader(t *testing.T) {
 	type args struct {
 		repoName        string
@@ -1,11 +1,14 @@
 package command
 
 import (
+	stdctx "context"
+	"errors"
 	"fmt"
 	"io"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/context"
@@ -19,6 +22,7 @@ import (
 
 func init() {
 	RootCmd.AddCommand(prCmd)
+	prCmd.AddCommand(prChecksCmd)
 	prCmd.AddCommand(prCheckoutCmd)
 	prCmd.AddCommand(prCreateCmd)
 	prCmd.AddCommand(prListCmd)
@@ -31,6 +35,9 @@ func init() {
 	prListCmd.Flags().StringSliceP("label", "l", nil, "Filter by label")
 	prListCmd.Flags().StringP("assignee", "a", "", "Filter by assignee")
 
+	prStatusCmd.Flags().Bool("watch", false, "Refresh the status view on an interval until interrupted")
+	prStatusCmd.Flags().Duration("interval", 5*time.Second, "Refresh interval to use with --watch")
+
 	prViewCmd.Flags().BoolP("web", "w", false, "Open pull request in browser")
 }
 
@@ -70,6 +77,8 @@ func prStatus(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
 
 	currentUser, err := ctx.AuthLogin()
 	if err != nil {
@@ -87,45 +96,120 @@ func prStatus(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("could not query for pull request for current branch: %w", err)
 	}
 
-	prPayload, err := api.PullRequests(apiClient, baseRepo, currentPRNumber, currentPRHeadRef, currentUser)
+	watch, err := cmd.Flags().GetBool("watch")
+	if err != nil {
+		return err
+	}
+	interval, err := cmd.Flags().GetDuration("interval")
 	if err != nil {
 		return err
 	}
 
 	out := colorableOut(cmd)
+	prevChecks := map[int]string{}
+
+	render := func() error {
+		prPayload, err := api.PullRequests(apiClient, baseRepo, currentPRNumber, currentPRHeadRef, currentUser)
+		if err != nil {
+			return err
+		}
 
-	fmt.Fprintln(out, "")
-	fmt.Fprintf(out, "Relevant pull requests in %s\n", ghrepo.FullName(baseRepo))
-	fmt.Fprintln(out, "")
+		fmt.Fprintln(out, "")
+		fmt.Fprintf(out, "Relevant pull requests in %s\n", ghrepo.FullName(baseRepo))
+		fmt.Fprintln(out, "")
 
-	printHeader(out, "Current branch")
-	if prPayload.CurrentPR != nil {
-		printPrs(out, 0, *prPayload.CurrentPR)
-	} else if currentPRHeadRef == "" {
-		printMessage(out, "  There is no current branch")
-	} else {
-		message := fmt.Sprintf("  There is no pull request associated with %s", utils.Cyan("["+currentPRHeadRef+"]"))
-		printMessage(out, message)
+		printHeader(out, "Current branch")
+		if prPayload.CurrentPR != nil {
+			printPrs(out, 0, *prPayload.CurrentPR)
+			printChecksTransitions(out, prevChecks, *prPayload.CurrentPR)
+		} else if currentPRHeadRef == "" {
+			printMessage(out, "  There is no current branch")
+		} else {
+			message := fmt.Sprintf("  There is no pull request associated with %s", utils.Cyan("["+currentPRHeadRef+"]"))
+			printMessage(out, message)
+		}
+		fmt.Fprintln(out)
+
+		printHeader(out, "Created by you")
+		if prPayload.ViewerCreated.TotalCount > 0 {
+			printPrs(out, prPayload.ViewerCreated.TotalCount, prPayload.ViewerCreated.PullRequests...)
+			for _, pr := range prPayload.ViewerCreated.PullRequests {
+				printChecksTransitions(out, prevChecks, pr)
+			}
+		} else {
+			printMessage(out, "  You have no open pull requests")
+		}
+		fmt.Fprintln(out)
+
+		printHeader(out, "Requesting a code review from you")
+		if prPayload.ReviewRequested.TotalCount > 0 {
+			printPrs(out, prPayload.ReviewRequested.TotalCount, prPayload.ReviewRequested.PullRequests...)
+			for _, pr := range prPayload.ReviewRequested.PullRequests {
+				printChecksTransitions(out, prevChecks, pr)
+			}
+		} else {
+			printMessage(out, "  You have no pull requests to review")
+		}
+		fmt.Fprintln(out)
+
+		return nil
 	}
-	fmt.Fprintln(out)
 
-	printHeader(out, "Created by you")
-	if prPayload.ViewerCreated.TotalCount > 0 {
-		printPrs(out, prPayload.ViewerCreated.TotalCount, prPayload.ViewerCreated.PullRequests...)
-	} else {
-		printMessage(out, "  You have no open pull requests")
+	if !watch {
+		return render()
 	}
-	fmt.Fprintln(out)
 
-	printHeader(out, "Requesting a code review from you")
-	if prPayload.ReviewRequested.TotalCount > 0 {
-		printPrs(out, prPayload.ReviewRequested.TotalCount, prPayload.ReviewRequested.PullRequests...)
-	} else {
-		printMessage(out, "  You have no pull requests to review")
+	return watchRender(out, interval, render)
+}
+
+// watchRender repeatedly clears the terminal and calls render until render
+// returns an error or the process is interrupted (e.g. by Ctrl+C). Unlike a
+// plain time.Sleep, the wait between renders is abandoned as soon as
+// processContext is canceled, so Ctrl+C takes effect immediately instead of
+// waiting out the rest of the interval. A Ctrl+C is the expected way to stop
+// watching, so it exits cleanly rather than surfacing as an error.
+func watchRender(out io.Writer, interval time.Duration, render func() error) error {
+	for {
+		fmt.Fprint(out, "\x1b[H\x1b[2J")
+		if err := render(); err != nil {
+			if errors.Is(processContext.Err(), stdctx.Canceled) {
+				return nil
+			}
+			return err
+		}
+		fmt.Fprintln(out, utils.Gray(fmt.Sprintf("Last updated %s — press Ctrl+C to stop watching", time.Now().Format("15:04:05"))))
+
+		select {
+		case <-processContext.Done():
+			if errors.Is(processContext.Err(), stdctx.Canceled) {
+				return nil
+			}
+			return processContext.Err()
+		case <-time.After(interval):
+		}
 	}
-	fmt.Fprintln(out)
+}
 
-	return nil
+// printChecksTransitions records pr's current checks summary in seen and,
+// when it differs from the summary seen on a previous call, prints a note if
+// checks are now failing or are now all passing. Other changes (e.g. to
+// pending) print nothing.
+func printChecksTransitions(w io.Writer, seen map[int]string, pr api.PullRequest) {
+	checks := pr.ChecksStatus()
+	signature := fmt.Sprintf("%d/%d/%d", checks.Failing, checks.Pending, checks.Passing)
+
+	previous, ok := seen[pr.Number]
+	seen[pr.Number] = signature
+	if !ok || previous == signature {
+		return
+	}
+
+	switch {
+	case checks.Failing > 0:
+		fmt.Fprintln(w, utils.Red(fmt.Sprintf("  ⚠ #%d checks started failing", pr.Number)))
+	case checks.Total > 0 && checks.Passing == checks.Total:
+		fmt.Fprintln(w, utils.Green(fmt.Sprintf("  ⚠ #%d checks are now passing", pr.Number)))
+	}
 }
 
 func prList(cmd *cobra.Command, args []string) error {
@@ -134,6 +218,8 @@ func prList(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
 
 	baseRepo, err := determineBaseRepo(cmd, ctx)
 	if err != nil {
@@ -254,6 +340,8 @@ func prView(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
 
 	var baseRepo ghrepo.Interface
 	var prArg string
@@ -358,6 +446,24 @@ func prFromArg(apiClient *api.Client, baseRepo ghrepo.Interface, arg string) (*a
 	return api.PullRequestForBranch(apiClient, baseRepo, "", arg)
 }
 
+// prFromArgOrCurrentBranch resolves a pull request from an explicit
+// <number>|<url>|<branch> argument, falling back to the pull request
+// associated with the current branch when no argument was given
+func prFromArgOrCurrentBranch(ctx context.Context, apiClient *api.Client, baseRepo ghrepo.Interface, prArg string) (*api.PullRequest, error) {
+	if prArg != "" {
+		return prFromArg(apiClient, baseRepo, prArg)
+	}
+
+	prNumber, branchWithOwner, err := prSelectorForCurrentBranch(ctx, baseRepo)
+	if err != nil {
+		return nil, err
+	}
+	if prNumber > 0 {
+		return api.PullRequestByNumber(apiClient, baseRepo, prNumber)
+	}
+	return api.PullRequestForBranch(apiClient, baseRepo, "", branchWithOwner)
+}
+
 func prSelectorForCurrentBranch(ctx context.Context, baseRepo ghrepo.Interface) (prNumber int, prHeadRef string, err error) {
 	prHeadRef, err = ctx.Branch()
 	if err != nil {
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"os/exec"
 
 	"github.com/spf13/cobra"
 
@@ -25,6 +24,8 @@ func prCheckout(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
 
 	var baseRepo ghrepo.Interface
 	prArg := args[0]
@@ -64,16 +65,16 @@ func prCheckout(cmd *cobra.Command, args []string) error {
 		remoteBranch := fmt.Sprintf("%s/%s", headRemote.Name, pr.HeadRefName)
 		refSpec := fmt.Sprintf("+refs/heads/%s:refs/remotes/%s", pr.HeadRefName, remoteBranch)
 
-		cmdQueue = append(cmdQueue, []string{"git", "fetch", headRemote.Name, refSpec})
+		cmdQueue = append(cmdQueue, []string{"fetch", headRemote.Name, refSpec})
 
 		// local branch already exists
 		if _, err := git.ShowRefs("refs/heads/" + newBranchName); err == nil {
-			cmdQueue = append(cmdQueue, []string{"git", "checkout", newBranchName})
-			cmdQueue = append(cmdQueue, []string{"git", "merge", "--ff-only", fmt.Sprintf("refs/remotes/%s", remoteBranch)})
+			cmdQueue = append(cmdQueue, []string{"checkout", newBranchName})
+			cmdQueue = append(cmdQueue, []string{"merge", "--ff-only", fmt.Sprintf("refs/remotes/%s", remoteBranch)})
 		} else {
-			cmdQueue = append(cmdQueue, []string{"git", "checkout", "-b", newBranchName, "--no-track", remoteBranch})
-			cmdQueue = append(cmdQueue, []string{"git", "config", fmt.Sprintf("branch.%s.remote", newBranchName), headRemote.Name})
-			cmdQueue = append(cmdQueue, []string{"git", "config", fmt.Sprintf("branch.%s.merge", newBranchName), "refs/heads/" + pr.HeadRefName})
+			cmdQueue = append(cmdQueue, []string{"checkout", "-b", newBranchName, "--no-track", remoteBranch})
+			cmdQueue = append(cmdQueue, []string{"config", fmt.Sprintf("branch.%s.remote", newBranchName), headRemote.Name})
+			cmdQueue = append(cmdQueue, []string{"config", fmt.Sprintf("branch.%s.merge", newBranchName), "refs/heads/" + pr.HeadRefName})
 		}
 	} else {
 		// no git remote for PR head
@@ -86,12 +87,12 @@ func prCheckout(cmd *cobra.Command, args []string) error {
 		ref := fmt.Sprintf("refs/pull/%d/head", pr.Number)
 		if newBranchName == currentBranch {
 			// PR head matches currently checked out branch
-			cmdQueue = append(cmdQueue, []string{"git", "fetch", baseURLOrName, ref})
-			cmdQueue = append(cmdQueue, []string{"git", "merge", "--ff-only", "FETCH_HEAD"})
+			cmdQueue = append(cmdQueue, []string{"fetch", baseURLOrName, ref})
+			cmdQueue = append(cmdQueue, []string{"merge", "--ff-only", "FETCH_HEAD"})
 		} else {
 			// create a new branch
-			cmdQueue = append(cmdQueue, []string{"git", "fetch", baseURLOrName, fmt.Sprintf("%s:%s", ref, newBranchName)})
-			cmdQueue = append(cmdQueue, []string{"git", "checkout", newBranchName})
+			cmdQueue = append(cmdQueue, []string{"fetch", baseURLOrName, fmt.Sprintf("%s:%s", ref, newBranchName)})
+			cmdQueue = append(cmdQueue, []string{"checkout", newBranchName})
 		}
 
 		remote := baseURLOrName
@@ -101,13 +102,13 @@ func prCheckout(cmd *cobra.Command, args []string) error {
 			mergeRef = fmt.Sprintf("refs/heads/%s", pr.HeadRefName)
 		}
 		if mc, err := git.Config(fmt.Sprintf("branch.%s.merge", newBranchName)); err != nil || mc == "" {
-			cmdQueue = append(cmdQueue, []string{"git", "config", fmt.Sprintf("branch.%s.remote", newBranchName), remote})
-			cmdQueue = append(cmdQueue, []string{"git", "config", fmt.Sprintf("branch.%s.merge", newBranchName), mergeRef})
+			cmdQueue = append(cmdQueue, []string{"config", fmt.Sprintf("branch.%s.remote", newBranchName), remote})
+			cmdQueue = append(cmdQueue, []string{"config", fmt.Sprintf("branch.%s.merge", newBranchName), mergeRef})
 		}
 	}
 
 	for _, args := range cmdQueue {
-		cmd := exec.Command(args[0], args[1:]...)
+		cmd := git.GitCommand(args...)
 		cmd.Stdout = os.Stdout
 		cmd.Stderr = os.Stderr
 		if err := run.PrepareCmd(cmd).Run(); err != nil {
@@ -0,0 +1,119 @@
+package command
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	prChecksCmd.Flags().Bool("watch", false, "Refresh the checks view on an interval until interrupted")
+	prChecksCmd.Flags().Duration("interval", 5*time.Second, "Refresh interval to use with --watch")
+	prChecksCmd.Flags().Bool("exit-status", false, "Exit with a non-zero status if any checks are failing or pending")
+}
+
+var prChecksCmd = &cobra.Command{
+	Use:   "checks [{<number> | <url> | <branch>}]",
+	Short: "Show CI status for a pull request",
+	RunE:  prChecks,
+}
+
+func prChecks(cmd *cobra.Command, args []string) error {
+	ctx := contextForCommand(cmd)
+
+	apiClient, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
+
+	baseRepo, err := determineBaseRepo(cmd, ctx)
+	if err != nil {
+		return err
+	}
+
+	var prArg string
+	if len(args) > 0 {
+		prArg = args[0]
+		if prNum, repo := prFromURL(prArg); repo != nil {
+			prArg = prNum
+			baseRepo = repo
+		}
+	}
+
+	watch, err := cmd.Flags().GetBool("watch")
+	if err != nil {
+		return err
+	}
+	interval, err := cmd.Flags().GetDuration("interval")
+	if err != nil {
+		return err
+	}
+	exitStatus, err := cmd.Flags().GetBool("exit-status")
+	if err != nil {
+		return err
+	}
+
+	out := colorableOut(cmd)
+	previousStates := map[string]string{}
+	var unfavorable bool
+
+	render := func() error {
+		pr, err := prFromArgOrCurrentBranch(ctx, apiClient, baseRepo, prArg)
+		if err != nil {
+			return err
+		}
+
+		checks := pr.ChecksList()
+		if len(checks) == 0 {
+			fmt.Fprintln(out, "No checks reported on this pull request")
+			unfavorable = false
+			return nil
+		}
+
+		unfavorable = false
+		table := utils.NewTablePrinter(out)
+		for _, c := range checks {
+			if c.State != "SUCCESS" && c.State != "NEUTRAL" && c.State != "SKIPPED" {
+				unfavorable = true
+			}
+
+			colorFunc, label := colorFuncForCheckState(c.State)
+			if previous, ok := previousStates[c.Name]; ok && previous != c.State {
+				label = fmt.Sprintf("%s (was %s)", label, previous)
+			}
+			previousStates[c.Name] = c.State
+
+			table.AddField(c.Name, nil, utils.Bold)
+			table.AddField(label, nil, colorFunc)
+			table.EndRow()
+		}
+		return table.Render()
+	}
+
+	if watch {
+		return watchRender(out, interval, render)
+	}
+
+	if err := render(); err != nil {
+		return err
+	}
+	if exitStatus && unfavorable {
+		return SilentError
+	}
+	return nil
+}
+
+func colorFuncForCheckState(state string) (func(string) string, string) {
+	switch state {
+	case "SUCCESS", "NEUTRAL", "SKIPPED":
+		return utils.Green, "✓ passing"
+	case "ERROR", "FAILURE", "CANCELLED", "TIMED_OUT", "ACTION_REQUIRED":
+		return utils.Red, "× failing"
+	default:
+		return utils.Yellow, "- pending"
+	}
+}
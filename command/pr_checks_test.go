@@ -0,0 +1,56 @@
+package command
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestPRChecks_exitStatus(t *testing.T) {
+	initBlankContext("OWNER/REPO", "blueberries")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "pullRequest": {
+		"number": 123,
+		"commits": { "nodes": [{ "commit": {
+			"statusCheckRollup": {
+				"contexts": { "nodes": [
+					{ "context": "build", "state": "SUCCESS" },
+					{ "name": "lint", "status": "COMPLETED", "conclusion": "FAILURE" }
+				] }
+			}
+		} }] }
+	} } } }
+	`))
+
+	_, err := RunCommand(prChecksCmd, "pr checks 123 --exit-status")
+	if !errors.Is(err, SilentError) {
+		t.Fatalf("expected SilentError, got %v", err)
+	}
+}
+
+func TestPRChecks_exitStatus_passing(t *testing.T) {
+	initBlankContext("OWNER/REPO", "blueberries")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "pullRequest": {
+		"number": 123,
+		"commits": { "nodes": [{ "commit": {
+			"statusCheckRollup": {
+				"contexts": { "nodes": [
+					{ "context": "build", "state": "SUCCESS" }
+				] }
+			}
+		} }] }
+	} } } }
+	`))
+
+	_, err := RunCommand(prChecksCmd, "pr checks 123 --exit-status")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
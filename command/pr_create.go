@@ -60,6 +60,8 @@ func prCreate(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return fmt.Errorf("could not initialize API client: %w", err)
 	}
+	client, cancel := withRequestTimeout(cmd, client)
+	defer cancel()
 
 	baseRepoOverride, _ := cmd.Flags().GetString("repo")
 	repoContext, err := context.ResolveRemotesToRepos(remotes, client, baseRepoOverride)
@@ -180,7 +182,8 @@ func prCreate(cmd *cobra.Command, _ []string) error {
 	}
 
 	// TODO: only drop into interactive mode if stdin & stdout are a tty
-	if !isWeb && !autofill && (title == "" || body == "") {
+	interactive := !isWeb && !autofill && (title == "" || body == "")
+	if interactive {
 		var templateFiles []string
 		if rootDir, err := git.ToplevelDir(); err == nil {
 			// TODO: figure out how to stub this in tests
@@ -282,6 +285,7 @@ func prCreate(cmd *cobra.Command, _ []string) error {
 			}
 			break
 		}
+		invalidateRepoBranches(headRepo)
 	}
 
 	if action == SubmitAction {
@@ -298,6 +302,42 @@ func prCreate(cmd *cobra.Command, _ []string) error {
 			return fmt.Errorf("failed to create pull request: %w", err)
 		}
 
+		reviewers, err := cmd.Flags().GetStringSlice("reviewer")
+		if err != nil {
+			return fmt.Errorf("could not parse reviewer: %w", err)
+		}
+		if len(reviewers) > 0 {
+			userIDs, teamIDs, err := reviewersToIDs(client, baseRepo, reviewers)
+			if err != nil {
+				return err
+			}
+			if err := api.RequestReviews(client, pr, userIDs, teamIDs); err != nil {
+				return fmt.Errorf("failed to request reviews: %w", err)
+			}
+		} else if suggested, err := api.PullRequestSuggestedReviewers(client, baseRepo, pr.Number); err == nil && len(suggested) > 0 {
+			logins := make([]string, len(suggested))
+			for i, s := range suggested {
+				logins[i] = s.Login
+			}
+			if interactive {
+				selected, err := selectReviewers(logins)
+				if err != nil {
+					return fmt.Errorf("could not prompt: %w", err)
+				}
+				if len(selected) > 0 {
+					userIDs, teamIDs, err := reviewersToIDs(client, baseRepo, selected)
+					if err != nil {
+						return err
+					}
+					if err := api.RequestReviews(client, pr, userIDs, teamIDs); err != nil {
+						return fmt.Errorf("failed to request reviews: %w", err)
+					}
+				}
+			} else {
+				fmt.Fprintf(cmd.ErrOrStderr(), "%s Suggested reviewers: %s (request with `gh pr edit --add-reviewer`)\n", utils.Yellow("!"), strings.Join(logins, ", "))
+			}
+		}
+
 		fmt.Fprintln(cmd.OutOrStdout(), pr.URL)
 	} else if action == PreviewAction {
 		openURL := generateCompareURL(baseRepo, baseBranch, headBranchLabel, title, body)
@@ -385,4 +425,5 @@ func init() {
 		"The branch into which you want your code merged")
 	prCreateCmd.Flags().BoolP("web", "w", false, "Open the web browser to create a pull request")
 	prCreateCmd.Flags().BoolP("fill", "f", false, "Do not prompt for title/body and just use commit info")
+	prCreateCmd.Flags().StringSliceP("reviewer", "r", nil, "Request a review from a user or team (e.g. \"monalisa\", \"my-org/my-team\")")
 }
@@ -0,0 +1,70 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	prCmd.AddCommand(prEditCmd)
+	prEditCmd.Flags().StringSliceP("add-reviewer", "r", nil, "Request a review from a user or team (e.g. \"monalisa\", \"my-org/my-team\")")
+}
+
+var prEditCmd = &cobra.Command{
+	Use:   "edit [{<number> | <url> | <branch>}]",
+	Short: "Request reviewers on a pull request",
+	RunE:  prEdit,
+}
+
+func prEdit(cmd *cobra.Command, args []string) error {
+	ctx := contextForCommand(cmd)
+
+	apiClient, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
+
+	baseRepo, err := determineBaseRepo(cmd, ctx)
+	if err != nil {
+		return err
+	}
+
+	var prArg string
+	if len(args) > 0 {
+		prArg = args[0]
+		if prNum, repo := prFromURL(prArg); repo != nil {
+			prArg = prNum
+			baseRepo = repo
+		}
+	}
+
+	reviewers, err := cmd.Flags().GetStringSlice("add-reviewer")
+	if err != nil {
+		return err
+	}
+	if len(reviewers) == 0 {
+		return fmt.Errorf("specify at least one change to make, e.g. `--add-reviewer`")
+	}
+
+	pr, err := prFromArgOrCurrentBranch(ctx, apiClient, baseRepo, prArg)
+	if err != nil {
+		return err
+	}
+
+	userIDs, teamIDs, err := reviewersToIDs(apiClient, baseRepo, reviewers)
+	if err != nil {
+		return err
+	}
+
+	if err := api.RequestReviews(apiClient, pr, userIDs, teamIDs); err != nil {
+		return fmt.Errorf("failed to request reviews: %w", err)
+	}
+
+	fmt.Fprintf(cmd.ErrOrStderr(), "Requested reviews for #%d from %s\n", pr.Number, strings.Join(reviewers, ", "))
+	return nil
+}
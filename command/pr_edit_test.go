@@ -0,0 +1,71 @@
+package command
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/cli/cli/internal/cache"
+)
+
+func withTempCacheDir(t *testing.T) func() {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "gh-cache-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := cache.Dir
+	cache.Dir = func() string { return dir }
+	return func() {
+		cache.Dir = orig
+		os.RemoveAll(dir)
+	}
+}
+
+func TestPREdit_addReviewer(t *testing.T) {
+	defer withTempCacheDir(t)()
+
+	initBlankContext("OWNER/REPO", "blueberries")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "pullRequest": {
+		"id": "THE-ID",
+		"number": 123,
+		"title": "The title of the PR"
+	} } } }
+	`))
+
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "assignableUsers": { "nodes": [
+		{ "id": "MONALISAID", "login": "monalisa" }
+	] } } } }
+	`))
+
+	http.StubResponse(200, bytes.NewBufferString(`{ "data": { "requestReviews": { "pullRequest": { "id": "THE-ID" } } } }`))
+
+	output, err := RunCommand(prEditCmd, "pr edit 123 --add-reviewer monalisa")
+	if err != nil {
+		t.Fatalf("error running command `pr edit`: %v", err)
+	}
+
+	if !bytes.Contains([]byte(output.Stderr()), []byte("Requested reviews for #123 from monalisa")) {
+		t.Errorf("unexpected output: %q", output.Stderr())
+	}
+}
+
+func TestPREdit_noChanges(t *testing.T) {
+	initBlankContext("OWNER/REPO", "blueberries")
+	http := initFakeHTTP()
+	http.StubRepoResponse("OWNER", "REPO")
+
+	_, err := RunCommand(prEditCmd, "pr edit 123")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if err.Error() != "specify at least one change to make, e.g. `--add-reviewer`" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -108,6 +108,8 @@ func repoClone(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
+		apiClient, cancel := withRequestTimeout(cmd, apiClient)
+		defer cancel()
 
 		parentRepo, err = api.RepoParent(apiClient, repo)
 		if err != nil {
@@ -216,6 +218,8 @@ func repoCreate(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	client, cancel := withRequestTimeout(cmd, client)
+	defer cancel()
 
 	repo, err := api.RepoCreate(client, input)
 	if err != nil {
@@ -309,6 +313,8 @@ func repoFork(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("unable to create client: %w", err)
 	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
 
 	var toFork ghrepo.Interface
 	inParent := false // whether or not we're forking the repo we're currently "in"
@@ -485,6 +491,8 @@ func repoView(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
 	repo, err := api.GitHubRepo(apiClient, toView)
 	if err != nil {
 		return err
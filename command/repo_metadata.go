@@ -0,0 +1,207 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/cache"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// metadataCacheTTL bounds how stale cached repo metadata (labels,
+// milestones, assignable users, branches) is allowed to get before it is
+// re-fetched from the API
+const metadataCacheTTL = time.Hour
+
+func labelsCacheKey(repo ghrepo.Interface) string {
+	return fmt.Sprintf("labels:%s", ghrepo.FullName(repo))
+}
+
+func milestonesCacheKey(repo ghrepo.Interface) string {
+	return fmt.Sprintf("milestones:%s", ghrepo.FullName(repo))
+}
+
+func assignableUsersCacheKey(repo ghrepo.Interface) string {
+	return fmt.Sprintf("assignable-users:%s", ghrepo.FullName(repo))
+}
+
+func branchesCacheKey(repo ghrepo.Interface) string {
+	return fmt.Sprintf("branches:%s", ghrepo.FullName(repo))
+}
+
+// cachedRepoLabels returns the labels defined on repo, using a short-TTL
+// on-disk cache shared with shell completion and interactive prompts
+func cachedRepoLabels(client *api.Client, repo ghrepo.Interface) ([]api.RepoLabel, error) {
+	key := labelsCacheKey(repo)
+
+	var labels []api.RepoLabel
+	if found, err := cache.Get(key, metadataCacheTTL, &labels); err == nil && found {
+		return labels, nil
+	}
+
+	labels, err := api.RepositoryLabels(client, repo)
+	if err != nil {
+		return nil, err
+	}
+	_ = cache.Set(key, labels)
+	return labels, nil
+}
+
+// cachedRepoMilestones returns the open milestones defined on repo, using
+// the same cache as cachedRepoLabels
+func cachedRepoMilestones(client *api.Client, repo ghrepo.Interface) ([]api.RepoMilestone, error) {
+	key := milestonesCacheKey(repo)
+
+	var milestones []api.RepoMilestone
+	if found, err := cache.Get(key, metadataCacheTTL, &milestones); err == nil && found {
+		return milestones, nil
+	}
+
+	milestones, err := api.RepositoryMilestones(client, repo)
+	if err != nil {
+		return nil, err
+	}
+	_ = cache.Set(key, milestones)
+	return milestones, nil
+}
+
+// cachedRepoAssignableUsers returns the users assignable on repo, using the
+// same cache as cachedRepoLabels
+func cachedRepoAssignableUsers(client *api.Client, repo ghrepo.Interface) ([]api.RepoAssignableUser, error) {
+	key := assignableUsersCacheKey(repo)
+
+	var users []api.RepoAssignableUser
+	if found, err := cache.Get(key, metadataCacheTTL, &users); err == nil && found {
+		return users, nil
+	}
+
+	users, err := api.RepositoryAssignableUsers(client, repo)
+	if err != nil {
+		return nil, err
+	}
+	_ = cache.Set(key, users)
+	return users, nil
+}
+
+// cachedRepoBranches returns the branch names that exist on repo, using the
+// same cache as cachedRepoLabels. Callers that push a new branch should
+// invalidate this entry via invalidateRepoBranches.
+func cachedRepoBranches(client *api.Client, repo ghrepo.Interface) ([]string, error) {
+	key := branchesCacheKey(repo)
+
+	var branches []string
+	if found, err := cache.Get(key, metadataCacheTTL, &branches); err == nil && found {
+		return branches, nil
+	}
+
+	branches, err := api.RepositoryBranchNames(client, repo)
+	if err != nil {
+		return nil, err
+	}
+	_ = cache.Set(key, branches)
+	return branches, nil
+}
+
+// invalidateRepoBranches drops the cached branch list for repo so that the
+// next lookup reflects a branch that was just pushed
+func invalidateRepoBranches(repo ghrepo.Interface) {
+	_ = cache.Invalidate(branchesCacheKey(repo))
+}
+
+// assigneeLoginsToIDs resolves assignee logins to their node IDs, erroring
+// out on the first login that isn't assignable on repo
+func assigneeLoginsToIDs(client *api.Client, repo ghrepo.Interface, logins []string) ([]string, error) {
+	users, err := cachedRepoAssignableUsers(client, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	byLogin := make(map[string]string, len(users))
+	for _, u := range users {
+		byLogin[u.Login] = u.ID
+	}
+
+	ids := make([]string, 0, len(logins))
+	for _, login := range logins {
+		id, ok := byLogin[login]
+		if !ok {
+			return nil, fmt.Errorf("%q is not assignable in %s", login, ghrepo.FullName(repo))
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// labelNamesToIDs resolves label names to their node IDs, erroring out on
+// the first name that doesn't match a label defined on repo
+func labelNamesToIDs(client *api.Client, repo ghrepo.Interface, names []string) ([]string, error) {
+	labels, err := cachedRepoLabels(client, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]string, len(labels))
+	for _, l := range labels {
+		byName[l.Name] = l.ID
+	}
+
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		id, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("%q is not a label in %s", name, ghrepo.FullName(repo))
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// reviewersToIDs resolves a list of reviewer handles to the user and team
+// node IDs expected by api.RequestReviews. A handle of the form
+// "org/team-slug" is resolved as a team; anything else is resolved as a user
+// login among repo's assignable users
+func reviewersToIDs(client *api.Client, repo ghrepo.Interface, handles []string) (userIDs []string, teamIDs []string, err error) {
+	for _, h := range handles {
+		h = strings.TrimPrefix(h, "@")
+		if org, slug, ok := splitTeamHandle(h); ok {
+			id, err := api.TeamID(client, org, slug)
+			if err != nil {
+				return nil, nil, err
+			}
+			teamIDs = append(teamIDs, id)
+			continue
+		}
+
+		ids, err := assigneeLoginsToIDs(client, repo, []string{h})
+		if err != nil {
+			return nil, nil, fmt.Errorf("%q is not a user or team that can review this pull request", h)
+		}
+		userIDs = append(userIDs, ids[0])
+	}
+	return
+}
+
+func splitTeamHandle(handle string) (org, slug string, ok bool) {
+	parts := strings.SplitN(handle, "/", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// milestoneTitleToID resolves a milestone's title to its node ID
+func milestoneTitleToID(client *api.Client, repo ghrepo.Interface, title string) (string, error) {
+	milestones, err := cachedRepoMilestones(client, repo)
+	if err != nil {
+		return "", err
+	}
+
+	for _, m := range milestones {
+		if m.Title == title {
+			return m.ID, nil
+		}
+	}
+	return "", fmt.Errorf("%q is not a milestone in %s", title, ghrepo.FullName(repo))
+}
@@ -0,0 +1,17 @@
+package command
+
+import "testing"
+
+func TestSplitTeamHandle(t *testing.T) {
+	org, slug, ok := splitTeamHandle("my-org/my-team")
+	if !ok {
+		t.Fatal("expected ok")
+	}
+	if org != "my-org" || slug != "my-team" {
+		t.Errorf("got org=%q slug=%q", org, slug)
+	}
+
+	if _, _, ok := splitTeamHandle("monalisa"); ok {
+		t.Error("expected a bare login to not be treated as a team handle")
+	}
+}
@@ -1,15 +1,20 @@
 package command
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"regexp"
 	"runtime/debug"
 	"strings"
+	"time"
+
+	stdctx "context"
 
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/context"
+	"github.com/cli/cli/git"
 	"github.com/cli/cli/internal/ghrepo"
 	"github.com/cli/cli/utils"
 
@@ -42,6 +47,7 @@ func init() {
 	RootCmd.SetVersionTemplate(versionOutput)
 
 	RootCmd.PersistentFlags().StringP("repo", "R", "", "Select another repository using the `OWNER/REPO` format")
+	RootCmd.PersistentFlags().String("timeout", "", "Cancel the command's GitHub API requests once this much time has passed, e.g. \"30s\" (also via GH_TIMEOUT)")
 	RootCmd.PersistentFlags().Bool("help", false, "Show help for command")
 	RootCmd.Flags().Bool("version", false, "Show gh version")
 	// TODO:
@@ -68,6 +74,11 @@ func (fe FlagError) Unwrap() error {
 	return fe.Err
 }
 
+// SilentError is returned to signal a non-zero exit code without printing an
+// error message, e.g. when `--exit-status` reports an unfavorable resource
+// state that isn't itself a failure of the command
+var SilentError = errors.New("SilentError")
+
 // RootCmd is the entry point of command-line execution
 var RootCmd = &cobra.Command{
 	Use:   "gh",
@@ -89,6 +100,46 @@ var versionCmd = &cobra.Command{
 	},
 }
 
+// processContext is canceled when gh itself should stop, e.g. on Ctrl-C. It
+// bounds both API requests and exec'd git subprocesses; SetContext also
+// propagates it to the git package.
+var processContext = stdctx.Background()
+
+// SetContext arranges for in-flight API requests and git subprocesses to be
+// aborted when ctx is done
+func SetContext(ctx stdctx.Context) {
+	processContext = ctx
+	git.SetContext(ctx)
+}
+
+// requestContext derives the context that bounds a command's GitHub API
+// requests, honoring --timeout/GH_TIMEOUT if one was given. The deadline
+// starts when it is called and is shared by every request made under it; an
+// unparsable duration is ignored.
+func requestContext(cmd *cobra.Command) (stdctx.Context, stdctx.CancelFunc) {
+	timeout, _ := cmd.Flags().GetString("timeout")
+	if timeout == "" {
+		timeout = os.Getenv("GH_TIMEOUT")
+	}
+	if timeout == "" {
+		return processContext, func() {}
+	}
+
+	d, err := time.ParseDuration(timeout)
+	if err != nil {
+		return processContext, func() {}
+	}
+	return stdctx.WithTimeout(processContext, d)
+}
+
+// withRequestTimeout binds an API client to the --timeout/GH_TIMEOUT-bound
+// context for the current command. Callers should defer the returned cancel
+// func.
+func withRequestTimeout(cmd *cobra.Command, c *api.Client) (*api.Client, stdctx.CancelFunc) {
+	ctx, cancel := requestContext(cmd)
+	return c.WithContext(ctx), cancel
+}
+
 // overridden in tests
 var initContext = func() context.Context {
 	ctx := context.New()
@@ -102,11 +153,25 @@ var initContext = func() context.Context {
 // user configuration
 func BasicClient() (*api.Client, error) {
 	var opts []api.ClientOption
+	c, err := context.ParseDefaultConfig()
+	if err == nil {
+		tlsOpt, err := api.AddTLSConfig(api.TLSConfig{
+			CABundleFile:       c.CABundle,
+			ClientCertFile:     c.ClientCert,
+			ClientKeyFile:      c.ClientKey,
+			ProxyURL:           c.ProxyURL,
+			InsecureSkipVerify: c.InsecureSkipVerify,
+		})
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, tlsOpt)
+	}
 	if verbose := os.Getenv("DEBUG"); verbose != "" {
 		opts = append(opts, apiVerboseLog())
 	}
 	opts = append(opts, api.AddHeader("User-Agent", fmt.Sprintf("GitHub CLI %s", Version)))
-	if c, err := context.ParseDefaultConfig(); err == nil {
+	if c != nil {
 		opts = append(opts, api.AddHeader("Authorization", fmt.Sprintf("token %s", c.Token)))
 	}
 	return api.NewClient(opts...), nil
@@ -127,6 +192,15 @@ var apiClientForContext = func(ctx context.Context) (*api.Client, error) {
 		return nil, err
 	}
 	var opts []api.ClientOption
+	tlsConfig, err := ctx.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	tlsOpt, err := api.AddTLSConfig(tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, tlsOpt)
 	if verbose := os.Getenv("DEBUG"); verbose != "" {
 		opts = append(opts, apiVerboseLog())
 	}
@@ -183,6 +257,8 @@ func determineBaseRepo(cmd *cobra.Command, ctx context.Context) (ghrepo.Interfac
 	if err != nil {
 		return nil, err
 	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
 
 	baseOverride, err := cmd.Flags().GetString("repo")
 	if err != nil {
@@ -0,0 +1,209 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	RootCmd.AddCommand(summaryCmd)
+	summaryCmd.Flags().StringP("since", "s", "1w", "Look back this far, e.g. \"1d\", \"2w\", \"3m\"")
+	summaryCmd.Flags().StringP("format", "f", "text", "Output format: {text|markdown|json}")
+}
+
+var summaryCmd = &cobra.Command{
+	Use:   "summary",
+	Short: "Summarize your recent contributions across GitHub",
+	Long: `Summarize your recent contributions across GitHub.
+
+Aggregates merged pull requests, reviews given, closed issues, and commits
+since the given time, across every repository you've contributed to.`,
+	RunE: summaryRun,
+}
+
+type contributionSummary struct {
+	Since        string          `json:"since"`
+	MergedPRs    summaryCategory `json:"mergedPullRequests"`
+	ReviewsGiven summaryCategory `json:"reviewsGiven"`
+	IssuesClosed summaryCategory `json:"issuesClosed"`
+	CommitCount  int             `json:"commitCount"`
+}
+
+type summaryCategory struct {
+	TotalCount int                `json:"totalCount"`
+	Items      []api.SearchResult `json:"items"`
+}
+
+func summaryRun(cmd *cobra.Command, args []string) error {
+	ctx := contextForCommand(cmd)
+	apiClient, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
+
+	currentUser, err := ctx.AuthLogin()
+	if err != nil {
+		return err
+	}
+
+	since, err := cmd.Flags().GetString("since")
+	if err != nil {
+		return err
+	}
+	sinceDate, err := parseSince(since)
+	if err != nil {
+		return err
+	}
+
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return err
+	}
+	if format != "text" && format != "markdown" && format != "json" {
+		return fmt.Errorf("unsupported --format: %q", format)
+	}
+
+	sinceQuery := sinceDate.Format("2006-01-02")
+	summary, err := gatherContributionSummary(apiClient, currentUser, since, sinceQuery)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	switch format {
+	case "json":
+		enc := json.NewEncoder(out)
+		return enc.Encode(summary)
+	case "markdown":
+		return printSummaryMarkdown(out, summary)
+	default:
+		return printSummaryText(out, summary)
+	}
+}
+
+// gatherContributionSummary runs the four search queries that make up a
+// contribution summary concurrently, since each is independent
+func gatherContributionSummary(apiClient *api.Client, currentUser, since, sinceQuery string) (*contributionSummary, error) {
+	var mergedPRs, reviewsGiven, issuesClosed summaryCategory
+	var commitCount int
+	var mergedErr, reviewedErr, issuesErr, commitsErr error
+
+	var wg sync.WaitGroup
+	wg.Add(4)
+
+	go func() {
+		defer wg.Done()
+		items, total, err := api.SearchIssues(apiClient, fmt.Sprintf("is:pr is:merged author:%s merged:>=%s", currentUser, sinceQuery))
+		mergedPRs = summaryCategory{TotalCount: total, Items: items}
+		mergedErr = err
+	}()
+	go func() {
+		defer wg.Done()
+		items, total, err := api.SearchIssues(apiClient, fmt.Sprintf("is:pr reviewed-by:%s -author:%s updated:>=%s", currentUser, currentUser, sinceQuery))
+		reviewsGiven = summaryCategory{TotalCount: total, Items: items}
+		reviewedErr = err
+	}()
+	go func() {
+		defer wg.Done()
+		items, total, err := api.SearchIssues(apiClient, fmt.Sprintf("is:issue is:closed author:%s closed:>=%s", currentUser, sinceQuery))
+		issuesClosed = summaryCategory{TotalCount: total, Items: items}
+		issuesErr = err
+	}()
+	go func() {
+		defer wg.Done()
+		commitCount, commitsErr = api.SearchCommitCount(apiClient, fmt.Sprintf("author:%s author-date:>=%s", currentUser, sinceQuery))
+	}()
+
+	wg.Wait()
+
+	for _, err := range []error{mergedErr, reviewedErr, issuesErr, commitsErr} {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &contributionSummary{
+		Since:        since,
+		MergedPRs:    mergedPRs,
+		ReviewsGiven: reviewsGiven,
+		IssuesClosed: issuesClosed,
+		CommitCount:  commitCount,
+	}, nil
+}
+
+// parseSince parses a relative duration like "1d", "2w", "3m", or "1y" into
+// an absolute point in time
+func parseSince(s string) (time.Time, error) {
+	if len(s) < 2 {
+		return time.Time{}, fmt.Errorf("invalid --since value: %q", s)
+	}
+
+	n, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --since value: %q", s)
+	}
+
+	now := time.Now()
+	switch s[len(s)-1] {
+	case 'd':
+		return now.AddDate(0, 0, -n), nil
+	case 'w':
+		return now.AddDate(0, 0, -7*n), nil
+	case 'm':
+		return now.AddDate(0, -n, 0), nil
+	case 'y':
+		return now.AddDate(-n, 0, 0), nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid --since unit %q (expected d, w, m, or y)", s[len(s)-1:])
+	}
+}
+
+func printSummaryText(w io.Writer, s *contributionSummary) error {
+	fmt.Fprintf(w, "Contribution summary since %s\n\n", s.Since)
+	printSummaryCategoryText(w, "Merged pull requests", s.MergedPRs)
+	printSummaryCategoryText(w, "Reviews given", s.ReviewsGiven)
+	printSummaryCategoryText(w, "Issues closed", s.IssuesClosed)
+	fmt.Fprintf(w, "%s: %d\n", utils.Bold("Commits"), s.CommitCount)
+	return nil
+}
+
+func printSummaryCategoryText(w io.Writer, label string, c summaryCategory) {
+	fmt.Fprintf(w, "%s: %d\n", utils.Bold(label), c.TotalCount)
+	for _, item := range c.Items {
+		fmt.Fprintf(w, "  #%d %s\n", item.Number, item.Title)
+	}
+	if c.TotalCount > len(c.Items) {
+		fmt.Fprintf(w, utils.Gray("  And %d more\n"), c.TotalCount-len(c.Items))
+	}
+	fmt.Fprintln(w)
+}
+
+func printSummaryMarkdown(w io.Writer, s *contributionSummary) error {
+	fmt.Fprintf(w, "## Contribution summary since %s\n\n", s.Since)
+	printSummaryCategoryMarkdown(w, "Merged pull requests", s.MergedPRs)
+	printSummaryCategoryMarkdown(w, "Reviews given", s.ReviewsGiven)
+	printSummaryCategoryMarkdown(w, "Issues closed", s.IssuesClosed)
+	fmt.Fprintf(w, "**Commits:** %d\n", s.CommitCount)
+	return nil
+}
+
+func printSummaryCategoryMarkdown(w io.Writer, label string, c summaryCategory) {
+	fmt.Fprintf(w, "**%s:** %d\n\n", label, c.TotalCount)
+	for _, item := range c.Items {
+		fmt.Fprintf(w, "- [#%d %s](%s)\n", item.Number, item.Title, item.URL)
+	}
+	if c.TotalCount > len(c.Items) {
+		fmt.Fprintf(w, "- And %d more\n", c.TotalCount-len(c.Items))
+	}
+	fmt.Fprintln(w)
+}
@@ -0,0 +1,80 @@
+package command
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/api"
+)
+
+func TestParseSince(t *testing.T) {
+	cases := map[string]time.Duration{
+		"1d": 24 * time.Hour,
+		"2w": 14 * 24 * time.Hour,
+	}
+	for in, want := range cases {
+		got, err := parseSince(in)
+		if err != nil {
+			t.Fatalf("parseSince(%q): unexpected error: %v", in, err)
+		}
+		if d := time.Since(got) - want; d < 0 || d > time.Minute {
+			t.Errorf("parseSince(%q) = %v, want roughly %v ago", in, got, want)
+		}
+	}
+
+	if _, err := parseSince("3x"); err == nil {
+		t.Error("expected an error for an unsupported unit")
+	}
+	if _, err := parseSince(""); err == nil {
+		t.Error("expected an error for an empty value")
+	}
+}
+
+func TestPrintSummaryText(t *testing.T) {
+	s := &contributionSummary{
+		Since: "1w",
+		MergedPRs: summaryCategory{
+			TotalCount: 2,
+			Items:      []api.SearchResult{{Number: 1, Title: "Fix the thing"}},
+		},
+		CommitCount: 5,
+	}
+
+	out := &bytes.Buffer{}
+	if err := printSummaryText(out, s); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := out.String()
+	if !strings.Contains(output, "#1 Fix the thing") {
+		t.Errorf("expected merged PR to be listed, got: %q", output)
+	}
+	if !strings.Contains(output, "And 1 more") {
+		t.Errorf("expected truncation notice, got: %q", output)
+	}
+	if !strings.Contains(output, "Commits: 5") {
+		t.Errorf("expected commit count, got: %q", output)
+	}
+}
+
+func TestPrintSummaryMarkdown(t *testing.T) {
+	s := &contributionSummary{
+		Since: "1w",
+		IssuesClosed: summaryCategory{
+			TotalCount: 1,
+			Items:      []api.SearchResult{{Number: 9, Title: "Flaky test", URL: "https://github.com/OWNER/REPO/issues/9"}},
+		},
+	}
+
+	out := &bytes.Buffer{}
+	if err := printSummaryMarkdown(out, s); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := out.String()
+	if !strings.Contains(output, "[#9 Flaky test](https://github.com/OWNER/REPO/issues/9)") {
+		t.Errorf("expected markdown link, got: %q", output)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
 	"github.com/cli/cli/pkg/githubtemplate"
 	"github.com/cli/cli/pkg/surveyext"
 	"github.com/spf13/cobra"
@@ -81,6 +83,24 @@ func selectTemplate(templatePaths []string) (string, error) {
 	return string(templateContents), nil
 }
 
+// selectReviewers lets the user pick which of GitHub's suggested reviewers
+// to request, rather than requesting all of them or none
+func selectReviewers(suggested []string) ([]string, error) {
+	answers := struct {
+		Reviewers []string
+	}{}
+	qs := []*survey.Question{
+		{
+			Name:   "reviewers",
+			Prompt: &survey.MultiSelect{Message: "Request a review from:", Options: suggested},
+		},
+	}
+	if err := SurveyAsk(qs, &answers); err != nil {
+		return nil, fmt.Errorf("could not prompt: %w", err)
+	}
+	return answers.Reviewers, nil
+}
+
 func titleBodySurvey(cmd *cobra.Command, providedTitle, providedBody string, defs defaults, templatePaths []string) (*titleBody, error) {
 	var inProgress titleBody
 	inProgress.Title = defs.Title
@@ -145,3 +165,91 @@ func titleBodySurvey(cmd *cobra.Command, providedTitle, providedBody string, def
 
 	return &inProgress, nil
 }
+
+// issueMetadataSurvey optionally prompts for labels, a milestone, and
+// assignees during an interactive create, offering the repo's cached labels,
+// milestones, and assignable users as pickable options instead of requiring
+// the caller to already know the exact names to pass as flags
+func issueMetadataSurvey(client *api.Client, repo ghrepo.Interface) (labels []string, milestone string, assignees []string, err error) {
+	confirmAnswers := struct {
+		Add bool
+	}{}
+	confirmQs := []*survey.Question{
+		{
+			Name: "add",
+			Prompt: &survey.Confirm{
+				Message: "Add labels, a milestone, or assignees?",
+				Default: false,
+			},
+		},
+	}
+	if err = SurveyAsk(confirmQs, &confirmAnswers); err != nil {
+		return nil, "", nil, fmt.Errorf("could not prompt: %w", err)
+	}
+	if !confirmAnswers.Add {
+		return nil, "", nil, nil
+	}
+
+	repoLabels, err := cachedRepoLabels(client, repo)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	labelNames := make([]string, len(repoLabels))
+	for i, l := range repoLabels {
+		labelNames[i] = l.Name
+	}
+
+	milestones, err := cachedRepoMilestones(client, repo)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	milestoneTitles := make([]string, 0, len(milestones)+1)
+	milestoneTitles = append(milestoneTitles, "")
+	for _, m := range milestones {
+		milestoneTitles = append(milestoneTitles, m.Title)
+	}
+
+	assignableUsers, err := cachedRepoAssignableUsers(client, repo)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	userLogins := make([]string, len(assignableUsers))
+	for i, u := range assignableUsers {
+		userLogins[i] = u.Login
+	}
+
+	answers := struct {
+		Labels    []string
+		Milestone string
+		Assignees []string
+	}{}
+
+	var qs []*survey.Question
+	if len(labelNames) > 0 {
+		qs = append(qs, &survey.Question{
+			Name:   "labels",
+			Prompt: &survey.MultiSelect{Message: "Labels", Options: labelNames},
+		})
+	}
+	if len(milestoneTitles) > 1 {
+		qs = append(qs, &survey.Question{
+			Name:   "milestone",
+			Prompt: &survey.Select{Message: "Milestone", Options: milestoneTitles},
+		})
+	}
+	if len(userLogins) > 0 {
+		qs = append(qs, &survey.Question{
+			Name:   "assignees",
+			Prompt: &survey.MultiSelect{Message: "Assignees", Options: userLogins},
+		})
+	}
+	if len(qs) == 0 {
+		return nil, "", nil, nil
+	}
+
+	if err = SurveyAsk(qs, &answers); err != nil {
+		return nil, "", nil, fmt.Errorf("could not prompt: %w", err)
+	}
+
+	return answers.Labels, answers.Milestone, answers.Assignees, nil
+}
@@ -0,0 +1,259 @@
+package command
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/git"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/internal/run"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+func init() {
+	RootCmd.AddCommand(workspaceCmd)
+	workspaceCmd.PersistentFlags().StringP("manifest", "f", "gh-workspace.yml", "Path to the workspace manifest file")
+
+	workspaceCmd.AddCommand(workspaceCloneCmd)
+	workspaceCmd.AddCommand(workspacePullCmd)
+	workspaceCmd.AddCommand(workspaceStatusCmd)
+
+	workspaceExecCmd.Flags().SetInterspersed(false)
+	workspaceCmd.AddCommand(workspaceExecCmd)
+}
+
+var workspaceCmd = &cobra.Command{
+	Use:   "workspace",
+	Short: "Manage a set of related repositories",
+	Long: `Work with a group of repositories defined in a workspace manifest.
+
+A workspace manifest is a YAML file that lists the repositories making up a
+project that spans more than one GitHub repository. By default, gh looks for
+"gh-workspace.yml" in the current directory; pass "-f" to use another file.`,
+}
+
+var workspaceCloneCmd = &cobra.Command{
+	Use:   "clone",
+	Short: "Clone every repository in the workspace",
+	RunE:  workspaceClone,
+}
+
+var workspacePullCmd = &cobra.Command{
+	Use:   "pull",
+	Short: "Pull the latest changes into every cloned repository",
+	RunE:  workspacePull,
+}
+
+var workspaceStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show branch and pull request status for every repository",
+	RunE:  workspaceStatus,
+}
+
+var workspaceExecCmd = &cobra.Command{
+	Use:   "exec -- <command>...",
+	Args:  cobra.MinimumNArgs(1),
+	Short: "Run a shell command in every cloned repository",
+	Long: `Run a shell command in every cloned repository in the workspace.
+
+Separate the command and its arguments from gh's own flags with "--":
+
+    gh workspace exec -- git status`,
+	RunE: workspaceExec,
+}
+
+// WorkspaceRepo is a single repository entry in a workspace manifest
+type WorkspaceRepo struct {
+	Name string `yaml:"name"`
+	Repo string `yaml:"repo"`
+	Path string `yaml:"path"`
+}
+
+// WorkspaceManifest describes the set of repositories that make up a workspace
+type WorkspaceManifest struct {
+	Repos []WorkspaceRepo `yaml:"repos"`
+}
+
+func (r WorkspaceRepo) localPath() string {
+	if r.Path != "" {
+		return r.Path
+	}
+	return r.Name
+}
+
+func loadWorkspaceManifest(fn string) (*WorkspaceManifest, error) {
+	data, err := ioutil.ReadFile(fn)
+	if err != nil {
+		return nil, fmt.Errorf("could not read workspace manifest %s: %w", fn, err)
+	}
+
+	var m WorkspaceManifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("could not parse workspace manifest %s: %w", fn, err)
+	}
+	if len(m.Repos) == 0 {
+		return nil, fmt.Errorf("workspace manifest %s does not list any repos", fn)
+	}
+
+	for i, r := range m.Repos {
+		if r.Repo == "" {
+			return nil, fmt.Errorf("entry %d in %s is missing a 'repo'", i, fn)
+		}
+		parts := strings.SplitN(r.Repo, "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("entry %d in %s has an invalid 'repo': %q is not in OWNER/REPO format", i, fn, r.Repo)
+		}
+		if r.Name == "" {
+			m.Repos[i].Name = parts[1]
+		}
+	}
+
+	return &m, nil
+}
+
+func workspaceManifestForCommand(cmd *cobra.Command) (*WorkspaceManifest, error) {
+	manifestPath, err := cmd.Flags().GetString("manifest")
+	if err != nil {
+		return nil, err
+	}
+	return loadWorkspaceManifest(manifestPath)
+}
+
+// runInRepo runs an exec.Cmd rooted at a workspace repo's local clone,
+// bound to processContext so it's killed along with any other gh subprocess
+// on Ctrl-C
+func runInRepo(localPath string, out, errOut io.Writer, args ...string) error {
+	c := exec.CommandContext(processContext, args[0], args[1:]...)
+	c.Dir = localPath
+	c.Stdout = out
+	c.Stderr = errOut
+	return run.PrepareCmd(c).Run()
+}
+
+func workspaceClone(cmd *cobra.Command, args []string) error {
+	manifest, err := workspaceManifestForCommand(cmd)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	for _, r := range manifest.Repos {
+		localPath := r.localPath()
+		if _, err := os.Stat(localPath); err == nil {
+			fmt.Fprintf(out, "%s %s already exists, skipping\n", utils.Bold(r.Name), utils.Gray("("+localPath+")"))
+			continue
+		}
+
+		fmt.Fprintf(out, "Cloning %s into %s\n", utils.Bold(r.Repo), localPath)
+		cloneURL := fmt.Sprintf("https://github.com/%s.git", r.Repo)
+		if err := runInRepo(".", out, cmd.ErrOrStderr(), "git", "clone", cloneURL, localPath); err != nil {
+			return fmt.Errorf("failed to clone %s: %w", r.Repo, err)
+		}
+	}
+
+	return nil
+}
+
+func workspacePull(cmd *cobra.Command, args []string) error {
+	return workspaceForEach(cmd, func(r WorkspaceRepo, out, errOut io.Writer) error {
+		return runInRepo(r.localPath(), out, errOut, "git", "pull", "--ff-only")
+	})
+}
+
+func workspaceExec(cmd *cobra.Command, args []string) error {
+	return workspaceForEach(cmd, func(r WorkspaceRepo, out, errOut io.Writer) error {
+		return runInRepo(r.localPath(), out, errOut, args...)
+	})
+}
+
+// workspaceForEach runs fn against every repo in the manifest that has
+// already been cloned locally, printing a header per repo and continuing
+// past individual failures so one broken clone doesn't block the rest.
+func workspaceForEach(cmd *cobra.Command, fn func(r WorkspaceRepo, out, errOut io.Writer) error) error {
+	manifest, err := workspaceManifestForCommand(cmd)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	errOut := cmd.ErrOrStderr()
+	var failed []string
+	for i, r := range manifest.Repos {
+		localPath := r.localPath()
+		if _, err := os.Stat(localPath); err != nil {
+			fmt.Fprintf(errOut, "%s %s\n", utils.Yellow("skipping"), localPath+" (not cloned)")
+			continue
+		}
+
+		if i > 0 {
+			fmt.Fprintln(out)
+		}
+		fmt.Fprintln(out, utils.Bold(fmt.Sprintf("== %s ==", r.Name)))
+		if err := fn(r, out, errOut); err != nil {
+			fmt.Fprintf(errOut, "%s %s: %s\n", utils.Red("error in"), r.Name, err)
+			failed = append(failed, r.Name)
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed in %d repositories: %s", len(failed), strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+func workspaceStatus(cmd *cobra.Command, args []string) error {
+	manifest, err := workspaceManifestForCommand(cmd)
+	if err != nil {
+		return err
+	}
+
+	ctx := contextForCommand(cmd)
+	apiClient, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+	apiClient, cancel := withRequestTimeout(cmd, apiClient)
+	defer cancel()
+
+	out := cmd.OutOrStdout()
+	table := utils.NewTablePrinter(out)
+	for _, r := range manifest.Repos {
+		localPath := r.localPath()
+		if _, err := os.Stat(localPath); err != nil {
+			table.AddField(r.Name, nil, utils.Bold)
+			table.AddField("not cloned", nil, utils.Gray)
+			table.AddField("", nil, nil)
+			table.EndRow()
+			continue
+		}
+
+		branchCmd := git.GitCommand("symbolic-ref", "--quiet", "--short", "HEAD")
+		branchCmd.Dir = localPath
+		branchOutput, err := run.PrepareCmd(branchCmd).Output()
+		branch := strings.TrimSpace(string(branchOutput))
+		if err != nil || branch == "" {
+			branch = "(detached)"
+		}
+
+		prStatus := ""
+		repo := ghrepo.FromFullName(r.Repo)
+		if pr, err := api.PullRequestForBranch(apiClient, repo, "", branch); err == nil {
+			prStatus = fmt.Sprintf("#%d %s", pr.Number, pr.State)
+		}
+
+		table.AddField(r.Name, nil, utils.Bold)
+		table.AddField(branch, nil, utils.Cyan)
+		table.AddField(prStatus, nil, utils.Gray)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
@@ -0,0 +1,143 @@
+package command
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/internal/run"
+	"github.com/cli/cli/test"
+)
+
+func writeWorkspaceManifest(t *testing.T, contents string) string {
+	t.Helper()
+	f, err := ioutil.TempFile("", "gh-workspace-test-*.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(contents); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name()
+}
+
+func TestWorkspaceStatus_notCloned(t *testing.T) {
+	manifestPath := writeWorkspaceManifest(t, `
+repos:
+  - repo: OWNER/REPO
+    path: /does/not/exist/gh-workspace-test
+`)
+	defer os.Remove(manifestPath)
+
+	initBlankContext("OWNER/REPO", "blueberries")
+	initFakeHTTP()
+
+	output, err := RunCommand(workspaceStatusCmd, "workspace status -f "+manifestPath)
+	if err != nil {
+		t.Fatalf("error running command `workspace status`: %v", err)
+	}
+
+	if !strings.Contains(output.String(), "not cloned") {
+		t.Errorf("expected output to report the repo as not cloned, got: %q", output.String())
+	}
+}
+
+func TestWorkspaceStatus_invalidRepo(t *testing.T) {
+	manifestPath := writeWorkspaceManifest(t, `
+repos:
+  - repo: notanownerslashrepo
+`)
+	defer os.Remove(manifestPath)
+
+	initBlankContext("OWNER/REPO", "blueberries")
+	initFakeHTTP()
+
+	_, err := RunCommand(workspaceStatusCmd, "workspace status -f "+manifestPath)
+	if err == nil || !strings.Contains(err.Error(), "invalid 'repo'") {
+		t.Fatalf("expected an invalid 'repo' error, got: %v", err)
+	}
+}
+
+func TestWorkspaceStatus_cloned(t *testing.T) {
+	localPath, err := ioutil.TempDir("", "gh-workspace-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(localPath)
+
+	manifestPath := writeWorkspaceManifest(t, `
+repos:
+  - repo: OWNER/REPO
+    path: `+filepath.ToSlash(localPath)+`
+`)
+	defer os.Remove(manifestPath)
+
+	initBlankContext("OWNER/REPO", "blueberries")
+	http := initFakeHTTP()
+	http.StubResponse(200, bytes.NewBufferString(`
+	{ "data": { "repository": { "pullRequests": { "nodes": [
+		{ "number": 123, "state": "OPEN", "headRefName": "feature", "isCrossRepository": false }
+	] } } } }
+	`))
+
+	restoreCmd := run.SetPrepareCmd(func(cmd *exec.Cmd) run.Runnable {
+		return &test.OutputStub{Out: []byte("feature\n")}
+	})
+	defer restoreCmd()
+
+	output, err := RunCommand(workspaceStatusCmd, "workspace status -f "+manifestPath)
+	if err != nil {
+		t.Fatalf("error running command `workspace status`: %v", err)
+	}
+
+	if !strings.Contains(output.String(), "feature") {
+		t.Errorf("expected output to show the checked-out branch, got: %q", output.String())
+	}
+	if !strings.Contains(output.String(), "#123") {
+		t.Errorf("expected output to show the associated pull request, got: %q", output.String())
+	}
+}
+
+func TestWorkspaceExec(t *testing.T) {
+	localPath, err := ioutil.TempDir("", "gh-workspace-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(localPath)
+
+	manifestPath := writeWorkspaceManifest(t, `
+repos:
+  - repo: OWNER/REPO
+    path: `+filepath.ToSlash(localPath)+`
+`)
+	defer os.Remove(manifestPath)
+
+	initBlankContext("OWNER/REPO", "blueberries")
+
+	var ranCommands [][]string
+	restoreCmd := run.SetPrepareCmd(func(cmd *exec.Cmd) run.Runnable {
+		ranCommands = append(ranCommands, cmd.Args)
+		return &test.OutputStub{}
+	})
+	defer restoreCmd()
+
+	output, err := RunCommand(workspaceExecCmd, "workspace exec -f "+manifestPath+" -- echo hi")
+	if err != nil {
+		t.Fatalf("error running command `workspace exec`: %v", err)
+	}
+
+	if len(ranCommands) != 1 {
+		t.Fatalf("expected 1 command to run, got %d", len(ranCommands))
+	}
+	if strings.Join(ranCommands[0], " ") != "echo hi" {
+		t.Errorf("unexpected command: %v", ranCommands[0])
+	}
+	if !strings.Contains(output.String(), "REPO") {
+		t.Errorf("expected output to print the repo header, got: %q", output.String())
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/cli/cli/api"
 	"github.com/cli/cli/git"
 	"github.com/cli/cli/internal/ghrepo"
 )
@@ -20,6 +21,7 @@ type blankContext struct {
 	branch    string
 	baseRepo  ghrepo.Interface
 	remotes   Remotes
+	tlsConfig api.TLSConfig
 }
 
 func (c *blankContext) AuthToken() (string, error) {
@@ -85,3 +87,11 @@ func (c *blankContext) BaseRepo() (ghrepo.Interface, error) {
 func (c *blankContext) SetBaseRepo(nwo string) {
 	c.baseRepo = ghrepo.FromFullName(nwo)
 }
+
+func (c *blankContext) TLSConfig() (api.TLSConfig, error) {
+	return c.tlsConfig, nil
+}
+
+func (c *blankContext) SetTLSConfig(cfg api.TLSConfig) {
+	c.tlsConfig = cfg
+}
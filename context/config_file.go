@@ -15,6 +15,14 @@ const defaultHostname = "github.com"
 type configEntry struct {
 	User  string
 	Token string `yaml:"oauth_token"`
+
+	// TLS and proxy settings for corporate networks and GitHub Enterprise
+	// instances behind a MITM proxy
+	CABundle           string `yaml:"ca_bundle,omitempty"`
+	ClientCert         string `yaml:"client_cert,omitempty"`
+	ClientKey          string `yaml:"client_key,omitempty"`
+	ProxyURL           string `yaml:"proxy_url,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify,omitempty"`
 }
 
 func parseOrSetupConfigFile(fn string) (*configEntry, error) {
@@ -22,6 +22,7 @@ type Context interface {
 	Remotes() (Remotes, error)
 	BaseRepo() (ghrepo.Interface, error)
 	SetBaseRepo(string)
+	TLSConfig() (api.TLSConfig, error)
 }
 
 // cap the number of git remotes looked up, since the user might have an
@@ -262,3 +263,19 @@ func (c *fsContext) BaseRepo() (ghrepo.Interface, error) {
 func (c *fsContext) SetBaseRepo(nwo string) {
 	c.baseRepo = ghrepo.FromFullName(nwo)
 }
+
+// TLSConfig reports the proxy and custom CA/client certificate settings
+// found in the user's config file, if any
+func (c *fsContext) TLSConfig() (api.TLSConfig, error) {
+	config, err := c.getConfig()
+	if err != nil {
+		return api.TLSConfig{}, err
+	}
+	return api.TLSConfig{
+		CABundleFile:       config.CABundle,
+		ClientCertFile:     config.ClientCert,
+		ClientKeyFile:      config.ClientKey,
+		ProxyURL:           config.ProxyURL,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}, nil
+}
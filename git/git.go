@@ -2,6 +2,7 @@ package git
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
@@ -13,6 +14,17 @@ import (
 	"github.com/cli/cli/internal/run"
 )
 
+// execContext is canceled to abort any git subprocess started by this
+// package, e.g. in response to Ctrl-C. It defaults to the background
+// context so git runs without a caller opting in.
+var execContext = context.Background()
+
+// SetContext arranges for subsequently started git subprocesses to be
+// killed when ctx is done
+func SetContext(ctx context.Context) {
+	execContext = ctx
+}
+
 // Ref represents a git commit reference
 type Ref struct {
 	Hash string
@@ -32,7 +44,7 @@ func (r TrackingRef) String() string {
 // ShowRefs resolves fully-qualified refs to commit hashes
 func ShowRefs(ref ...string) ([]Ref, error) {
 	args := append([]string{"show-ref", "--verify", "--"}, ref...)
-	showRef := exec.Command("git", args...)
+	showRef := GitCommand(args...)
 	output, err := run.PrepareCmd(showRef).Output()
 
 	var refs []Ref
@@ -73,13 +85,13 @@ func CurrentBranch() (string, error) {
 }
 
 func listRemotes() ([]string, error) {
-	remoteCmd := exec.Command("git", "remote", "-v")
+	remoteCmd := GitCommand("remote", "-v")
 	output, err := run.PrepareCmd(remoteCmd).Output()
 	return outputLines(output), err
 }
 
 func Config(name string) (string, error) {
-	configCmd := exec.Command("git", "config", name)
+	configCmd := GitCommand("config", name)
 	output, err := run.PrepareCmd(configCmd).Output()
 	if err != nil {
 		return "", fmt.Errorf("unknown config key: %s", name)
@@ -90,7 +102,7 @@ func Config(name string) (string, error) {
 }
 
 var GitCommand = func(args ...string) *exec.Cmd {
-	return exec.Command("git", args...)
+	return exec.CommandContext(execContext, "git", args...)
 }
 
 func UncommittedChangeCount() (int, error) {
@@ -207,9 +219,59 @@ func isFilesystemPath(p string) bool {
 	return p == "." || strings.HasPrefix(p, "./") || strings.HasPrefix(p, "/")
 }
 
+// Branch is a local branch along with its upstream tracking state
+type Branch struct {
+	Name        string
+	Upstream    string
+	AheadCount  int
+	BehindCount int
+	Gone        bool
+}
+
+var branchTrackRE = regexp.MustCompile(`ahead (\d+)|behind (\d+)`)
+
+// Branches lists local branches that have an upstream tracking ref, along
+// with how far ahead of or behind that upstream they are
+func Branches() ([]Branch, error) {
+	branchCmd := GitCommand(
+		"for-each-ref",
+		"--format=%(refname:short)%09%(upstream:short)%09%(upstream:track)",
+		"refs/heads/",
+	)
+	output, err := run.PrepareCmd(branchCmd).Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var branches []Branch
+	for _, line := range outputLines(output) {
+		parts := strings.SplitN(line, "\t", 3)
+		if len(parts) < 2 || parts[1] == "" {
+			continue
+		}
+
+		b := Branch{Name: parts[0], Upstream: parts[1]}
+		if len(parts) == 3 {
+			track := parts[2]
+			b.Gone = strings.Contains(track, "gone")
+			for _, m := range branchTrackRE.FindAllStringSubmatch(track, -1) {
+				if m[1] != "" {
+					fmt.Sscanf(m[1], "%d", &b.AheadCount)
+				}
+				if m[2] != "" {
+					fmt.Sscanf(m[2], "%d", &b.BehindCount)
+				}
+			}
+		}
+		branches = append(branches, b)
+	}
+
+	return branches, nil
+}
+
 // ToplevelDir returns the top-level directory path of the current repository
 func ToplevelDir() (string, error) {
-	showCmd := exec.Command("git", "rev-parse", "--show-toplevel")
+	showCmd := GitCommand("rev-parse", "--show-toplevel")
 	output, err := run.PrepareCmd(showCmd).Output()
 	return firstLine(output), err
 
@@ -217,13 +279,17 @@ func ToplevelDir() (string, error) {
 
 func outputLines(output []byte) []string {
 	lines := strings.TrimSuffix(string(output), "\n")
-	return strings.Split(lines, "\n")
-
+	lines = strings.TrimSuffix(lines, "\r")
+	split := strings.Split(lines, "\n")
+	for i, line := range split {
+		split[i] = strings.TrimSuffix(line, "\r")
+	}
+	return split
 }
 
 func firstLine(output []byte) string {
 	if i := bytes.IndexAny(output, "\n"); i >= 0 {
-		return string(output)[0:i]
+		return strings.TrimSuffix(string(output)[0:i], "\r")
 	}
-	return string(output)
+	return strings.TrimSuffix(string(output), "\r")
 }
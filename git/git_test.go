@@ -57,6 +57,21 @@ func Test_CurrentBranch(t *testing.T) {
 	}
 }
 
+func Test_CurrentBranch_crlf(t *testing.T) {
+	cs, teardown := test.InitCmdStubber()
+	defer teardown()
+
+	cs.Stub("branch-name\r\n")
+
+	result, err := CurrentBranch()
+	if err != nil {
+		t.Errorf("got unexpected error: %v", err)
+	}
+	if result != "branch-name" {
+		t.Errorf("unexpected branch name: %q", result)
+	}
+}
+
 func Test_CurrentBranch_detached_head(t *testing.T) {
 	cs, teardown := test.InitCmdStubber()
 	defer teardown()
@@ -76,6 +91,28 @@ func Test_CurrentBranch_detached_head(t *testing.T) {
 	}
 }
 
+func Test_Branches(t *testing.T) {
+	cs, teardown := test.InitCmdStubber()
+	defer teardown()
+
+	cs.Stub("master\torigin/master\t\nfeature\torigin/feature\t[ahead 2, behind 1]\nstale\torigin/stale\t[gone]\nwip\t\t")
+
+	branches, err := Branches()
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+	if len(branches) != 3 {
+		t.Fatalf("expected 3 branches with upstreams, got %d", len(branches))
+	}
+
+	if branches[1].Name != "feature" || branches[1].AheadCount != 2 || branches[1].BehindCount != 1 {
+		t.Errorf("unexpected branch: %+v", branches[1])
+	}
+	if !branches[2].Gone {
+		t.Errorf("expected %q to be reported as gone", branches[2].Name)
+	}
+}
+
 func Test_CurrentBranch_unexpected_error(t *testing.T) {
 	cs, teardown := test.InitCmdStubber()
 	defer teardown()
@@ -2,7 +2,6 @@ package git
 
 import (
 	"net/url"
-	"os/exec"
 	"regexp"
 	"strings"
 
@@ -73,7 +72,7 @@ func parseRemotes(gitRemotes []string) (remotes RemoteSet) {
 
 // AddRemote adds a new git remote and auto-fetches objects from it
 func AddRemote(name, u string) (*Remote, error) {
-	addCmd := exec.Command("git", "remote", "add", "-f", name, u)
+	addCmd := GitCommand("remote", "add", "-f", name, u)
 	err := run.PrepareCmd(addCmd).Run()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,93 @@
+// Package cache implements a short-TTL, on-disk cache for repository
+// metadata (labels, milestones, assignable users, branches) that is
+// expensive to look up over the API but changes infrequently. It is shared
+// by shell completion, interactive prompts, and flag validation.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cli/cli/context"
+)
+
+// Dir is the directory entries are stored under; overridden in tests
+var Dir = func() string {
+	return filepath.Join(context.ConfigDir(), "cache")
+}
+
+type entry struct {
+	CachedAt time.Time
+	Data     json.RawMessage
+}
+
+// Get reads the cached value for key into v if a fresh entry exists, and
+// reports whether it found one. A cache miss is not an error.
+func Get(key string, ttl time.Duration, v interface{}) (bool, error) {
+	b, err := ioutil.ReadFile(path(key))
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	var e entry
+	if err := json.Unmarshal(b, &e); err != nil {
+		return false, nil
+	}
+	if time.Since(e.CachedAt) > ttl {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(e.Data, v); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Set writes v to the cache under key, stamped with the current time.
+func Set(key string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(entry{CachedAt: time.Now(), Data: data})
+	if err != nil {
+		return err
+	}
+
+	dir := Dir()
+	if err := os.MkdirAll(dir, 0771); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path(key), b, 0600)
+}
+
+// Invalidate removes the cached entry for key, if any. It is safe to call
+// on a key that was never cached.
+func Invalidate(key string) error {
+	err := os.Remove(path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// Clear removes every cached entry.
+func Clear() error {
+	err := os.RemoveAll(Dir())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(Dir(), hex.EncodeToString(sum[:])+".json")
+}
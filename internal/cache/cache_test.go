@@ -0,0 +1,105 @@
+package cache
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func withTempDir(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "gh-cache-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := Dir
+	Dir = func() string { return dir }
+	return func() {
+		Dir = orig
+		os.RemoveAll(dir)
+	}
+}
+
+func TestGetSet(t *testing.T) {
+	defer withTempDir(t)()
+
+	if err := Set("labels:OWNER/REPO", []string{"bug", "enhancement"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var labels []string
+	found, err := Get("labels:OWNER/REPO", time.Hour, &labels)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !found {
+		t.Fatal("expected a cache hit")
+	}
+	if len(labels) != 2 || labels[0] != "bug" {
+		t.Errorf("unexpected labels: %v", labels)
+	}
+}
+
+func TestGet_miss(t *testing.T) {
+	defer withTempDir(t)()
+
+	var v []string
+	found, err := Get("nonexistent", time.Hour, &v)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if found {
+		t.Error("expected a cache miss")
+	}
+}
+
+func TestGet_expired(t *testing.T) {
+	defer withTempDir(t)()
+
+	if err := Set("key", "value"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var v string
+	found, err := Get("key", -time.Second, &v)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if found {
+		t.Error("expected the entry to be considered expired")
+	}
+}
+
+func TestInvalidate(t *testing.T) {
+	defer withTempDir(t)()
+
+	Set("key", "value")
+	if err := Invalidate("key"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var v string
+	found, _ := Get("key", time.Hour, &v)
+	if found {
+		t.Error("expected entry to be gone after Invalidate")
+	}
+
+	if err := Invalidate("never-was-cached"); err != nil {
+		t.Errorf("unexpected error invalidating a missing key: %v", err)
+	}
+}
+
+func TestClear(t *testing.T) {
+	defer withTempDir(t)()
+
+	Set("a", "1")
+	Set("b", "2")
+	if err := Clear(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var v string
+	if found, _ := Get("a", time.Hour, &v); found {
+		t.Error("expected cache to be empty after Clear")
+	}
+}
@@ -24,7 +24,7 @@ var (
 
 func init() {
 	if runtime.GOOS == "windows" {
-		editor = "notepad"
+		editor = defaultWindowsEditor()
 	} else if g := os.Getenv("GIT_EDITOR"); g != "" {
 		editor = g
 	} else if v := os.Getenv("VISUAL"); v != "" {
@@ -34,6 +34,16 @@ func init() {
 	}
 }
 
+// defaultWindowsEditor prefers VS Code, since it's a common default editor
+// among Windows developers and blocks until its window is closed when
+// passed "--wait", falling back to notepad, which ships with every install
+func defaultWindowsEditor() string {
+	if _, err := exec.LookPath("code"); err == nil {
+		return "code --wait"
+	}
+	return "notepad"
+}
+
 // EXTENDED to enable different prompting behavior
 type GhEditor struct {
 	*survey.Editor
@@ -0,0 +1,30 @@
+//go:build windows
+// +build windows
+
+package utils
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+func init() {
+	enableVirtualTerminalProcessing(os.Stdout)
+	enableVirtualTerminalProcessing(os.Stderr)
+}
+
+// enableVirtualTerminalProcessing turns on native ANSI escape sequence
+// handling for the given console so that modern terminals (Windows
+// Terminal, recent conhost) don't need byte-by-byte translation
+func enableVirtualTerminalProcessing(f *os.File) {
+	stdHandle := windows.Handle(f.Fd())
+
+	var mode uint32
+	if err := windows.GetConsoleMode(stdHandle, &mode); err != nil {
+		return
+	}
+
+	mode |= windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING
+	_ = windows.SetConsoleMode(stdHandle, mode)
+}